@@ -0,0 +1,45 @@
+package array
+
+// DifferenceArray records range additions over a fixed-length array in O(1)
+// each and applies them all at once in Build. diff[i] holds how much element
+// i exceeds element i-1, with one extra slot so that a range ending at the
+// last element needs no special case.
+type DifferenceArray struct {
+	diff []int
+}
+
+func NewDifferenceArray(initial []int) *DifferenceArray {
+	d := &DifferenceArray{diff: make([]int, len(initial)+1)}
+
+	previous := 0
+	i := 0
+	for i < len(initial) {
+		d.diff[i] = initial[i] - previous
+		previous = initial[i]
+		i++
+	}
+
+	return d
+}
+
+// RangeAdd adds delta to every element in [l, r], both inclusive.
+func (d *DifferenceArray) RangeAdd(l, r, delta int) {
+	d.diff[l] += delta
+	d.diff[r+1] -= delta
+}
+
+// Build returns the array with every RangeAdd so far applied, in O(n). The
+// DifferenceArray stays usable for further updates.
+func (d *DifferenceArray) Build() []int {
+	out := make([]int, len(d.diff)-1)
+
+	running := 0
+	i := 0
+	for i < len(out) {
+		running += d.diff[i]
+		out[i] = running
+		i++
+	}
+
+	return out
+}
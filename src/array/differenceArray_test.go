@@ -0,0 +1,64 @@
+package array
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestDifferenceArrayMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(266))
+
+	trial := 0
+	for trial < 50 {
+		n := 1 + r.Intn(40)
+		want := make([]int, n)
+		for i := range want {
+			want[i] = r.Intn(101) - 50
+		}
+		d := NewDifferenceArray(want)
+
+		// Always touch both boundaries so the extra trailing slot is exercised.
+		updates := [][3]int{{0, 0, 7}, {n - 1, n - 1, -3}, {0, n - 1, 2}}
+		k := 0
+		for k < 100 {
+			l := r.Intn(n)
+			updates = append(updates, [3]int{l, l + r.Intn(n-l), r.Intn(21) - 10})
+			k++
+		}
+
+		for _, u := range updates {
+			d.RangeAdd(u[0], u[1], u[2])
+			i := u[0]
+			for i <= u[1] {
+				want[i] += u[2]
+				i++
+			}
+		}
+
+		if got := d.Build(); !slices.Equal(got, want) {
+			t.Fatalf("Build() = %v, want %v", got, want)
+		}
+		trial++
+	}
+}
+
+func TestDifferenceArrayBuildIsRepeatable(t *testing.T) {
+	d := NewDifferenceArray([]int{1, 2, 3})
+	if got, want := d.Build(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("Build() with no updates = %v, want %v", got, want)
+	}
+
+	d.RangeAdd(1, 2, 10)
+	if got, want := d.Build(), []int{1, 12, 13}; !slices.Equal(got, want) {
+		t.Errorf("Build() = %v, want %v", got, want)
+	}
+	d.RangeAdd(0, 0, -1)
+	if got, want := d.Build(), []int{0, 12, 13}; !slices.Equal(got, want) {
+		t.Errorf("Build() after a second update = %v, want %v", got, want)
+	}
+
+	if got := NewDifferenceArray(nil).Build(); len(got) != 0 {
+		t.Errorf("NewDifferenceArray(nil).Build() = %v, want empty", got)
+	}
+}
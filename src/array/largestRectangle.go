@@ -0,0 +1,36 @@
+package array
+
+// LargestRectangleInHistogram returns the area of the largest rectangle under
+// the histogram heights, where every bar has width one. A stack holds indices
+// of bars in increasing height; when a shorter bar arrives, each taller bar
+// popped can extend no further right, and the bar below it on the stack marks
+// how far it extends left. Each bar is pushed and popped once, so this is
+// O(n).
+func LargestRectangleInHistogram(heights []int) int {
+	best := 0
+	var stack []int
+
+	i := 0
+	for i <= len(heights) {
+		current := 0
+		if i < len(heights) {
+			current = heights[i]
+		}
+
+		for len(stack) > 0 && heights[stack[len(stack)-1]] >= current {
+			height := heights[stack[len(stack)-1]]
+			stack = stack[:len(stack)-1]
+
+			left := -1
+			if len(stack) > 0 {
+				left = stack[len(stack)-1]
+			}
+			best = max(best, height*(i-left-1))
+		}
+
+		stack = append(stack, i)
+		i++
+	}
+
+	return best
+}
@@ -0,0 +1,59 @@
+package array
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func bruteLargestRectangle(heights []int) int {
+	best := 0
+	l := 0
+	for l < len(heights) {
+		lowest := heights[l]
+		r := l
+		for r < len(heights) {
+			lowest = min(lowest, heights[r])
+			best = max(best, lowest*(r-l+1))
+			r++
+		}
+		l++
+	}
+	return best
+}
+
+func TestLargestRectangleInHistogram(t *testing.T) {
+	cases := []struct {
+		heights []int
+		want    int
+	}{
+		{[]int{2, 1, 5, 6, 2, 3}, 10},
+		{[]int{1, 2, 3, 4, 5}, 9},
+		{[]int{5, 4, 3, 2, 1}, 9},
+		{[]int{3, 3, 3, 3}, 12},
+		{[]int{7}, 7},
+		{[]int{0, 0}, 0},
+		{nil, 0},
+	}
+	for _, c := range cases {
+		if got := LargestRectangleInHistogram(c.heights); got != c.want {
+			t.Errorf("LargestRectangleInHistogram(%v) = %d, want %d", c.heights, got, c.want)
+		}
+	}
+}
+
+func TestLargestRectangleMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(273))
+
+	trial := 0
+	for trial < 500 {
+		heights := make([]int, r.Intn(20))
+		for i := range heights {
+			heights[i] = r.Intn(10)
+		}
+
+		if got, want := LargestRectangleInHistogram(heights), bruteLargestRectangle(heights); got != want {
+			t.Fatalf("LargestRectangleInHistogram(%v) = %d, want %d", heights, got, want)
+		}
+		trial++
+	}
+}
@@ -0,0 +1,33 @@
+package array
+
+// LongestSubstringKDistinct returns the length, in runes, of the longest
+// substring of s with at most k distinct runes. The window grows one rune at a
+// time and shrinks from the left whenever it holds more than k distinct runes.
+func LongestSubstringKDistinct(s string, k int) int {
+	if k <= 0 {
+		return 0
+	}
+
+	runes := []rune(s)
+	counts := map[rune]int{}
+	best := 0
+
+	left := 0
+	right := 0
+	for right < len(runes) {
+		counts[runes[right]]++
+
+		for len(counts) > k {
+			counts[runes[left]]--
+			if counts[runes[left]] == 0 {
+				delete(counts, runes[left])
+			}
+			left++
+		}
+
+		best = max(best, right-left+1)
+		right++
+	}
+
+	return best
+}
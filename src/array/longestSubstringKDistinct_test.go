@@ -0,0 +1,27 @@
+package array
+
+import "testing"
+
+func TestLongestSubstringKDistinct(t *testing.T) {
+	cases := []struct {
+		s    string
+		k    int
+		want int
+	}{
+		{"eceba", 2, 3},
+		{"eceba", 0, 0},
+		{"eceba", 10, 5},
+		{"", 2, 0},
+		{"aa", 1, 2},
+		{"abaccc", 2, 4},
+		{"aabbcc", 1, 2},
+		{"héllo wörld", 3, 4},
+		{"日本日本語", 2, 4},
+		{"abc", -1, 0},
+	}
+	for _, c := range cases {
+		if got := LongestSubstringKDistinct(c.s, c.k); got != c.want {
+			t.Errorf("LongestSubstringKDistinct(%q, %d) = %d, want %d", c.s, c.k, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package array
+
+// MaximalRectangle returns the area of the largest rectangle of ones in a
+// binary matrix. Row by row it keeps, for each column, the number of
+// consecutive ones ending at that row, and the best rectangle with its base on
+// the row is the largest rectangle in that histogram. Rows may differ in
+// length; cells past the end of a row count as 0.
+func MaximalRectangle(matrix [][]int) int {
+	width := 0
+	for _, row := range matrix {
+		width = max(width, len(row))
+	}
+
+	heights := make([]int, width)
+	best := 0
+
+	for _, row := range matrix {
+		j := 0
+		for j < width {
+			if j < len(row) && row[j] == 1 {
+				heights[j]++
+			} else {
+				heights[j] = 0
+			}
+			j++
+		}
+
+		best = max(best, LargestRectangleInHistogram(heights))
+	}
+
+	return best
+}
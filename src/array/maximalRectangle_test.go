@@ -0,0 +1,98 @@
+package array
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func bruteMaximalRectangle(matrix [][]int) int {
+	width := 0
+	for _, row := range matrix {
+		width = max(width, len(row))
+	}
+
+	best := 0
+	for r1 := range matrix {
+		c1 := 0
+		for c1 < width {
+			r2 := r1
+			for r2 < len(matrix) {
+				c2 := c1
+				for c2 < width {
+					ones := true
+					for _, row := range matrix[r1 : r2+1] {
+						c := c1
+						for c <= c2 {
+							if c >= len(row) || row[c] != 1 {
+								ones = false
+							}
+							c++
+						}
+					}
+					if ones {
+						best = max(best, (r2-r1+1)*(c2-c1+1))
+					}
+					c2++
+				}
+				r2++
+			}
+			c1++
+		}
+	}
+	return best
+}
+
+func TestMaximalRectangle(t *testing.T) {
+	cases := []struct {
+		name   string
+		matrix [][]int
+		want   int
+	}{
+		{"known", [][]int{
+			{1, 0, 1, 0, 0},
+			{1, 0, 1, 1, 1},
+			{1, 1, 1, 1, 1},
+			{1, 0, 0, 1, 0},
+		}, 6},
+		{"all zeros", [][]int{{0, 0, 0}, {0, 0, 0}}, 0},
+		{"all ones", [][]int{{1, 1, 1}, {1, 1, 1}, {1, 1, 1}}, 9},
+		{"single row", [][]int{{1, 1, 0, 1, 1, 1}}, 3},
+		{"single column", [][]int{{1}, {1}, {0}, {1}}, 2},
+		{"empty", nil, 0},
+		{"longer later row", [][]int{{1}, {1, 1, 1}, {1, 1, 1}}, 6},
+		{"shorter later row", [][]int{{1, 1, 1}, {1, 1, 1}, {1}, {1, 1, 1}}, 6},
+	}
+	for _, c := range cases {
+		if got := MaximalRectangle(c.matrix); got != c.want {
+			t.Errorf("%s: MaximalRectangle(%v) = %d, want %d", c.name, c.matrix, got, c.want)
+		}
+	}
+}
+
+func TestMaximalRectangleMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(274))
+
+	trial := 0
+	for trial < 300 {
+		rows, cols := 1+r.Intn(6), 1+r.Intn(6)
+		matrix := make([][]int, rows)
+		for i := range matrix {
+			// Every fourth trial has ragged rows.
+			if trial%4 == 0 {
+				cols = r.Intn(7)
+			}
+			matrix[i] = make([]int, cols)
+			for j := range matrix[i] {
+				// Mostly ones, so that large rectangles are common.
+				if r.Intn(4) > 0 {
+					matrix[i][j] = 1
+				}
+			}
+		}
+
+		if got, want := MaximalRectangle(matrix), bruteMaximalRectangle(matrix); got != want {
+			t.Fatalf("MaximalRectangle(%v) = %d, want %d", matrix, got, want)
+		}
+		trial++
+	}
+}
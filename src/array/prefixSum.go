@@ -0,0 +1,51 @@
+package array
+
+// PrefixSum returns p with p[0] = 0 and p[i+1] = s[0] + ... + s[i], so any
+// range sum of s is a difference of two entries.
+func PrefixSum(s []int) []int {
+	p := make([]int, len(s)+1)
+
+	i := 0
+	for i < len(s) {
+		p[i+1] = p[i] + s[i]
+		i++
+	}
+
+	return p
+}
+
+// RangeSum returns s[l] + ... + s[r] from p = PrefixSum(s) in O(1).
+func RangeSum(p []int, l, r int) int {
+	return p[r+1] - p[l]
+}
+
+// PrefixSum2D returns p where p[i+1][j+1] is the sum of m[0..i][0..j], with a
+// zero first row and column. Rows of m must all have the same length.
+func PrefixSum2D(m [][]int) [][]int {
+	rows, cols := len(m), 0
+	if rows > 0 {
+		cols = len(m[0])
+	}
+
+	p := make([][]int, rows+1)
+	p[0] = make([]int, cols+1)
+
+	i := 0
+	for i < rows {
+		p[i+1] = make([]int, cols+1)
+		j := 0
+		for j < cols {
+			p[i+1][j+1] = m[i][j] + p[i][j+1] + p[i+1][j] - p[i][j]
+			j++
+		}
+		i++
+	}
+
+	return p
+}
+
+// RectSum returns the sum of m over rows r1..r2 and columns c1..c2, all
+// inclusive, from p = PrefixSum2D(m) in O(1).
+func RectSum(p [][]int, r1, c1, r2, c2 int) int {
+	return p[r2+1][c2+1] - p[r1][c2+1] - p[r2+1][c1] + p[r1][c1]
+}
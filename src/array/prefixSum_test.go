@@ -0,0 +1,87 @@
+package array
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRangeSumMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(265))
+
+	trial := 0
+	for trial < 50 {
+		n := 1 + r.Intn(30)
+		s := make([]int, n)
+		for i := range s {
+			s[i] = r.Intn(201) - 100
+		}
+		p := PrefixSum(s)
+
+		l := 0
+		for l < n {
+			right := l
+			for right < n {
+				want := 0
+				for _, v := range s[l : right+1] {
+					want += v
+				}
+				if got := RangeSum(p, l, right); got != want {
+					t.Fatalf("RangeSum(PrefixSum(%v), %d, %d) = %d, want %d", s, l, right, got, want)
+				}
+				right++
+			}
+			l++
+		}
+		trial++
+	}
+}
+
+func TestPrefixSumEmpty(t *testing.T) {
+	if p := PrefixSum(nil); len(p) != 1 || p[0] != 0 {
+		t.Errorf("PrefixSum(nil) = %v, want [0]", p)
+	}
+}
+
+func TestRectSumMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(265))
+
+	trial := 0
+	for trial < 30 {
+		rows, cols := 1+r.Intn(8), 1+r.Intn(8)
+		m := make([][]int, rows)
+		for i := range m {
+			m[i] = make([]int, cols)
+			for j := range m[i] {
+				m[i][j] = r.Intn(21) - 10
+			}
+		}
+		p := PrefixSum2D(m)
+
+		r1 := 0
+		for r1 < rows {
+			r2 := r1
+			for r2 < rows {
+				c1 := 0
+				for c1 < cols {
+					c2 := c1
+					for c2 < cols {
+						want := 0
+						for _, row := range m[r1 : r2+1] {
+							for _, v := range row[c1 : c2+1] {
+								want += v
+							}
+						}
+						if got := RectSum(p, r1, c1, r2, c2); got != want {
+							t.Fatalf("RectSum(PrefixSum2D(%v), %d, %d, %d, %d) = %d, want %d", m, r1, c1, r2, c2, got, want)
+						}
+						c2++
+					}
+					c1++
+				}
+				r2++
+			}
+			r1++
+		}
+		trial++
+	}
+}
@@ -0,0 +1,49 @@
+package array
+
+import "sort"
+
+// SortedUnique works on a copy and leaves s untouched. Elements are considered
+// equal when neither is less than the other.
+func SortedUnique[T any](s []T, less func(a, b T) bool) []T {
+	sorted := make([]T, len(s))
+	copy(sorted, s)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	if len(sorted) == 0 {
+		return sorted
+	}
+
+	last := 0
+	i := 1
+	for i < len(sorted) {
+		if less(sorted[last], sorted[i]) {
+			last++
+			sorted[last] = sorted[i]
+		}
+		i++
+	}
+
+	return sorted[:last+1]
+}
+
+// DedupeSorted compacts s in place and returns the shortened slice, which
+// shares s's backing array. No allocation takes place.
+func DedupeSorted[T comparable](s []T) []T {
+	if len(s) == 0 {
+		return s
+	}
+
+	last := 0
+	i := 1
+	for i < len(s) {
+		if s[i] != s[last] {
+			last++
+			s[last] = s[i]
+		}
+		i++
+	}
+
+	return s[:last+1]
+}
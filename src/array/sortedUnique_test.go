@@ -0,0 +1,58 @@
+package array
+
+import (
+	"reflect"
+	"testing"
+)
+
+func intLess(a, b int) bool {
+	return a < b
+}
+
+func TestSortedUnique(t *testing.T) {
+	cases := []struct {
+		in, want []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{7, 7, 7, 7}, []int{7}},
+		{[]int{3, 1, 2}, []int{1, 2, 3}},
+		{[]int{5, 1, 5, 3, 1}, []int{1, 3, 5}},
+	}
+
+	for _, c := range cases {
+		in := append([]int{}, c.in...)
+		if got := SortedUnique(in, intLess); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("SortedUnique(%v) = %v, want %v", c.in, got, c.want)
+		}
+		if !reflect.DeepEqual(in, c.in) {
+			t.Errorf("SortedUnique modified its input to %v", in)
+		}
+	}
+}
+
+func TestDedupeSorted(t *testing.T) {
+	cases := []struct {
+		in, want []int
+	}{
+		{nil, nil},
+		{[]int{2, 2, 2}, []int{2}},
+		{[]int{1, 2, 3}, []int{1, 2, 3}},
+		{[]int{1, 1, 2, 3, 3}, []int{1, 2, 3}},
+	}
+
+	for _, c := range cases {
+		if got := DedupeSorted(append([]int(nil), c.in...)); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("DedupeSorted(%v) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestDedupeSortedDoesNotAllocate(t *testing.T) {
+	s := []int{1, 1, 2, 2, 3, 3, 4}
+	allocs := testing.AllocsPerRun(100, func() {
+		DedupeSorted(s)
+	})
+	if allocs != 0 {
+		t.Fatalf("DedupeSorted allocated %v times", allocs)
+	}
+}
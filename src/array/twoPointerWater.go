@@ -0,0 +1,50 @@
+package array
+
+// MaxArea returns the most water held between two of the vertical lines in
+// heights, with the x-axis as the bottom. The shorter side of the current
+// pair bounds every narrower pair that keeps it, so that side is the one
+// moved inwards.
+func MaxArea(heights []int) int {
+	best := 0
+	left := 0
+	right := len(heights) - 1
+
+	for left < right {
+		width := right - left
+		if heights[left] < heights[right] {
+			best = max(best, width*heights[left])
+			left++
+		} else {
+			best = max(best, width*heights[right])
+			right--
+		}
+	}
+
+	return best
+}
+
+// TrapRainWater returns how much water the elevation map in heights holds
+// after rain. The water over a bar is bounded by the lower of the highest bars
+// on either side, and whichever running maximum is lower is already that
+// bound for the bar next to it.
+func TrapRainWater(heights []int) int {
+	water := 0
+	left := 0
+	right := len(heights) - 1
+	leftMax := 0
+	rightMax := 0
+
+	for left < right {
+		if heights[left] < heights[right] {
+			leftMax = max(leftMax, heights[left])
+			water += leftMax - heights[left]
+			left++
+		} else {
+			rightMax = max(rightMax, heights[right])
+			water += rightMax - heights[right]
+			right--
+		}
+	}
+
+	return water
+}
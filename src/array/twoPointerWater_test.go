@@ -0,0 +1,43 @@
+package array
+
+import "testing"
+
+func TestMaxArea(t *testing.T) {
+	cases := []struct {
+		heights []int
+		want    int
+	}{
+		{[]int{1, 8, 6, 2, 5, 4, 8, 3, 7}, 49},
+		{[]int{1, 1}, 1},
+		{[]int{4, 3, 2, 1, 4}, 16},
+		{[]int{1, 2, 1}, 2},
+		{nil, 0},
+		{[]int{5}, 0},
+	}
+	for _, c := range cases {
+		if got := MaxArea(c.heights); got != c.want {
+			t.Errorf("MaxArea(%v) = %d, want %d", c.heights, got, c.want)
+		}
+	}
+}
+
+func TestTrapRainWater(t *testing.T) {
+	cases := []struct {
+		heights []int
+		want    int
+	}{
+		{[]int{0, 1, 0, 2, 1, 0, 1, 3, 2, 1, 2, 1}, 6},
+		{[]int{4, 2, 0, 3, 2, 5}, 9},
+		{nil, 0},
+		{[]int{3}, 0},
+		{[]int{1, 2, 3, 4, 5}, 0},
+		{[]int{5, 4, 3, 2, 1}, 0},
+		// A valley filled to the height of its walls: 3+2+1+2+3.
+		{[]int{4, 1, 2, 3, 2, 1, 4}, 11},
+	}
+	for _, c := range cases {
+		if got := TrapRainWater(c.heights); got != c.want {
+			t.Errorf("TrapRainWater(%v) = %d, want %d", c.heights, got, c.want)
+		}
+	}
+}
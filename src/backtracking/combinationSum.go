@@ -0,0 +1,60 @@
+package backtracking
+
+import "sort"
+
+func combinationSum(candidates []int, target int, reuse bool) [][]int {
+	// Zero or negative candidates would let the search recurse without ever
+	// getting closer to the target, so only positive ones take part.
+	var sorted []int
+	for _, c := range candidates {
+		if c > 0 {
+			sorted = append(sorted, c)
+		}
+	}
+	sort.Ints(sorted)
+
+	var results [][]int
+	var current []int
+
+	var search func(start, remaining int)
+	search = func(start, remaining int) {
+		if remaining == 0 {
+			results = append(results, append([]int{}, current...))
+			return
+		}
+
+		i := start
+		for i < len(sorted) && sorted[i] <= remaining {
+			if i == start || sorted[i] != sorted[i-1] {
+				next := i + 1
+				if reuse {
+					next = i
+				}
+
+				current = append(current, sorted[i])
+				search(next, remaining-sorted[i])
+				current = current[:len(current)-1]
+			}
+			i++
+		}
+	}
+
+	search(0, target)
+	return results
+}
+
+// CombinationSum returns every multiset of candidates, each usable any number
+// of times, that adds up to target. Each combination is in ascending order.
+// Candidates that are not positive are skipped, and duplicates among them are
+// ignored.
+func CombinationSum(candidates []int, target int) [][]int {
+	return combinationSum(candidates, target, true)
+}
+
+// CombinationSumNoReuse is CombinationSum where each candidate may be used at
+// most once, again skipping candidates that are not positive. Equal candidates
+// are interchangeable, so only the first of a run of equal values is tried at
+// each depth to avoid duplicate combinations.
+func CombinationSumNoReuse(candidates []int, target int) [][]int {
+	return combinationSum(candidates, target, false)
+}
@@ -0,0 +1,44 @@
+package backtracking
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCombinationSum(t *testing.T) {
+	cases := []struct {
+		candidates []int
+		target     int
+		want       [][]int
+	}{
+		{[]int{2, 3, 6, 7}, 7, [][]int{{2, 2, 3}, {7}}},
+		{[]int{2, 3, 5}, 8, [][]int{{2, 2, 2, 2}, {2, 3, 3}, {3, 5}}},
+		{[]int{3, 2, 2}, 4, [][]int{{2, 2}}},
+		{[]int{2}, 1, nil},
+		{[]int{0, -1, 1}, 2, [][]int{{1, 1}}},
+		{[]int{5}, 0, [][]int{{}}},
+	}
+	for _, c := range cases {
+		if got := CombinationSum(c.candidates, c.target); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("CombinationSum(%v, %d) = %v, want %v", c.candidates, c.target, got, c.want)
+		}
+	}
+}
+
+func TestCombinationSumNoReuse(t *testing.T) {
+	cases := []struct {
+		candidates []int
+		target     int
+		want       [][]int
+	}{
+		{[]int{10, 1, 2, 7, 6, 1, 5}, 8, [][]int{{1, 1, 6}, {1, 2, 5}, {1, 7}, {2, 6}}},
+		{[]int{2, 5, 2, 1, 2}, 5, [][]int{{1, 2, 2}, {5}}},
+		{[]int{2}, 4, nil},
+		{[]int{0, 0, 3}, 3, [][]int{{3}}},
+	}
+	for _, c := range cases {
+		if got := CombinationSumNoReuse(c.candidates, c.target); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("CombinationSumNoReuse(%v, %d) = %v, want %v", c.candidates, c.target, got, c.want)
+		}
+	}
+}
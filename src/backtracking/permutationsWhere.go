@@ -0,0 +1,37 @@
+package backtracking
+
+// PermutationsWhere builds permutations of items one element at a time and
+// abandons a branch as soon as valid rejects the partial permutation, so valid
+// must hold for every prefix of a result, not only for the complete one.
+func PermutationsWhere[T any](items []T, valid func(partial []T) bool) [][]T {
+	var results [][]T
+	partial := make([]T, 0, len(items))
+	used := make([]bool, len(items))
+
+	var extend func()
+	extend = func() {
+		if len(partial) == len(items) {
+			results = append(results, append([]T{}, partial...))
+			return
+		}
+
+		i := 0
+		for i < len(items) {
+			if !used[i] {
+				used[i] = true
+				partial = append(partial, items[i])
+
+				if valid(partial) {
+					extend()
+				}
+
+				partial = partial[:len(partial)-1]
+				used[i] = false
+			}
+			i++
+		}
+	}
+
+	extend()
+	return results
+}
@@ -0,0 +1,93 @@
+package backtracking
+
+import (
+	"fmt"
+	"testing"
+)
+
+func allPermutations(items []int) [][]int {
+	if len(items) == 0 {
+		return [][]int{{}}
+	}
+
+	var results [][]int
+	for i, first := range items {
+		rest := append(append([]int{}, items[:i]...), items[i+1:]...)
+		for _, p := range allPermutations(rest) {
+			results = append(results, append([]int{first}, p...))
+		}
+	}
+	return results
+}
+
+func noAdjacentConsecutive(p []int) bool {
+	i := 1
+	for i < len(p) {
+		if d := p[i] - p[i-1]; d == 1 || d == -1 {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+func TestPermutationsWhereNoAdjacentConsecutive(t *testing.T) {
+	// Counts of such permutations of 1..n are 1, 1, 0, 0, 2, 14, 90, 646.
+	wantCounts := []int{1, 1, 0, 0, 2, 14, 90, 646}
+
+	for n, wantCount := range wantCounts {
+		items := make([]int, n)
+		for i := range items {
+			items[i] = i + 1
+		}
+
+		got := PermutationsWhere(items, func(partial []int) bool {
+			last := len(partial) - 1
+			if last == 0 {
+				return true
+			}
+			d := partial[last] - partial[last-1]
+			return d != 1 && d != -1
+		})
+
+		brute := 0
+		for _, p := range allPermutations(items) {
+			if noAdjacentConsecutive(p) {
+				brute++
+			}
+		}
+
+		if len(got) != brute || brute != wantCount {
+			t.Errorf("n=%d: PermutationsWhere found %d, brute force %d, want %d", n, len(got), brute, wantCount)
+		}
+
+		seen := map[string]bool{}
+		for _, p := range got {
+			if len(p) != n || !noAdjacentConsecutive(p) {
+				t.Errorf("n=%d: invalid result %v", n, p)
+			}
+			key := fmt.Sprint(p)
+			if seen[key] {
+				t.Errorf("n=%d: duplicate result %v", n, p)
+			}
+			seen[key] = true
+		}
+	}
+}
+
+func TestPermutationsWherePrunes(t *testing.T) {
+	calls := 0
+	got := PermutationsWhere([]int{1, 2, 3, 4}, func(partial []int) bool {
+		calls++
+		return partial[0] == 1
+	})
+
+	// Only branches starting with 1 survive, so valid sees the 4 one-element
+	// prefixes and then just the 3 + 6 + 6 longer prefixes that start with 1.
+	if len(got) != 6 {
+		t.Errorf("got %d permutations starting with 1, want 6", len(got))
+	}
+	if calls != 4+3+6+6 {
+		t.Errorf("valid called %d times, want %d", calls, 4+3+6+6)
+	}
+}
@@ -0,0 +1,73 @@
+package bloom
+
+const COUNTER_MAX = 15
+
+// CountingFilter is a Bloom filter with a 4-bit counter per slot instead of a
+// bit, which makes Remove possible. A counter that reaches COUNTER_MAX is
+// saturated: it no longer tracks how many items share it and is never
+// decremented again, so its slot stays set for good rather than risk a false
+// negative.
+type CountingFilter struct {
+	counters []byte
+	size     uint64
+	hashes   int
+}
+
+// NewCountingFilter creates a filter with size counters and hashes hash
+// functions, both raised to at least one.
+func NewCountingFilter(size, hashes int) *CountingFilter {
+	size = max(size, 1)
+	return &CountingFilter{
+		counters: make([]byte, (size+1)/2),
+		size:     uint64(size),
+		hashes:   max(hashes, 1),
+	}
+}
+
+func (f *CountingFilter) counter(slot uint64) byte {
+	return f.counters[slot/2] >> (4 * (slot % 2)) & 0xf
+}
+
+func (f *CountingFilter) setCounter(slot uint64, value byte) {
+	shift := 4 * (slot % 2)
+	f.counters[slot/2] = f.counters[slot/2]&^(0xf<<shift) | (value&0xf)<<shift
+}
+
+func (f *CountingFilter) Add(data []byte) {
+	for _, slot := range locations(data, f.hashes, f.size) {
+		if c := f.counter(slot); c < COUNTER_MAX {
+			f.setCounter(slot, c+1)
+		}
+	}
+}
+
+// Remove undoes one Add of data. If data does not currently test positive it
+// cannot have been added, and Remove returns false without touching any
+// counter. Removing an item that tests positive only through collisions still
+// decrements other items' counters, so only remove what was added.
+func (f *CountingFilter) Remove(data []byte) bool {
+	slots := locations(data, f.hashes, f.size)
+	for _, slot := range slots {
+		if f.counter(slot) == 0 {
+			return false
+		}
+	}
+
+	// A slot can repeat among an item's locations, so a counter may reach
+	// zero before the last decrement meant for it; it stays at zero.
+	for _, slot := range slots {
+		if c := f.counter(slot); c > 0 && c < COUNTER_MAX {
+			f.setCounter(slot, c-1)
+		}
+	}
+	return true
+}
+
+func (f *CountingFilter) MayContain(data []byte) bool {
+	for _, slot := range locations(data, f.hashes, f.size) {
+		if f.counter(slot) == 0 {
+			return false
+		}
+	}
+	return true
+}
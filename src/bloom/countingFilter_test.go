@@ -0,0 +1,101 @@
+package bloom
+
+import "testing"
+
+func TestCountingFilterAddRemove(t *testing.T) {
+	f := NewCountingFilter(4096, 4)
+	item := []byte("transient")
+
+	f.Add(item)
+	if !f.MayContain(item) {
+		t.Fatalf("MayContain = false after Add")
+	}
+	if !f.Remove(item) {
+		t.Fatalf("Remove = false for an added item")
+	}
+	if f.MayContain(item) {
+		t.Errorf("MayContain = true after Remove")
+	}
+}
+
+func TestCountingFilterRemoveKeepsOthers(t *testing.T) {
+	f := NewCountingFilter(2048, 4)
+	members := keys("member", 100)
+	for _, k := range members {
+		f.Add(k)
+	}
+
+	removedAny := false
+	for _, k := range keys("stranger", 100) {
+		if f.MayContain(k) {
+			continue
+		}
+		if f.Remove(k) {
+			t.Errorf("Remove(%q) = true for an absent item", k)
+		}
+		removedAny = true
+	}
+	if !removedAny {
+		t.Fatalf("every stranger tested positive; the filter is too small")
+	}
+
+	for _, k := range members {
+		if !f.MayContain(k) {
+			t.Errorf("member %q lost after removing absent items", k)
+		}
+	}
+}
+
+func TestCountingFilterSharedSlots(t *testing.T) {
+	// Adding the same item twice and removing it once must leave it present.
+	f := NewCountingFilter(64, 3)
+	item := []byte("twice")
+	f.Add(item)
+	f.Add(item)
+	f.Remove(item)
+	if !f.MayContain(item) {
+		t.Errorf("item removed once of two Adds no longer present")
+	}
+
+	f.Remove(item)
+	if f.MayContain(item) {
+		t.Errorf("item still present after both Adds were removed")
+	}
+}
+
+func TestCountingFilterSaturation(t *testing.T) {
+	f := NewCountingFilter(16, 2)
+	item := []byte("hot")
+
+	i := 0
+	for i < COUNTER_MAX+5 {
+		f.Add(item)
+		i++
+	}
+	for _, slot := range locations(item, f.hashes, f.size) {
+		if c := f.counter(slot); c != COUNTER_MAX {
+			t.Fatalf("counter %d = %d, want it saturated at %d", slot, c, COUNTER_MAX)
+		}
+	}
+
+	// Saturated counters never go down, so the item can't be removed.
+	i = 0
+	for i < COUNTER_MAX+5 {
+		f.Remove(item)
+		i++
+	}
+	if !f.MayContain(item) {
+		t.Errorf("saturated item disappeared after removals")
+	}
+}
+
+func TestCountingFilterNeighbourCounters(t *testing.T) {
+	// Two counters share each byte; updating one must leave the other alone.
+	f := NewCountingFilter(4, 1)
+	f.setCounter(0, 9)
+	f.setCounter(1, COUNTER_MAX)
+	f.setCounter(0, 3)
+	if f.counter(0) != 3 || f.counter(1) != COUNTER_MAX {
+		t.Errorf("counters = %d, %d, want 3, %d", f.counter(0), f.counter(1), COUNTER_MAX)
+	}
+}
@@ -0,0 +1,58 @@
+package bloom
+
+import "hash/fnv"
+
+// locations derives the k slots for data from two base hashes using
+// double hashing, which behaves like k independent hash functions.
+func locations(data []byte, hashes int, size uint64) []uint64 {
+	a := fnv.New64a()
+	a.Write(data)
+	h1 := a.Sum64()
+
+	b := fnv.New64()
+	b.Write(data)
+	h2 := b.Sum64() | 1
+
+	slots := make([]uint64, hashes)
+	i := 0
+	for i < hashes {
+		slots[i] = (h1 + uint64(i)*h2) % size
+		i++
+	}
+
+	return slots
+}
+
+// Filter is a Bloom filter: MayContain never reports false for an added item,
+// but may report true for one that was never added.
+type Filter struct {
+	bits   []uint64
+	size   uint64
+	hashes int
+}
+
+// NewFilter creates a filter with size bits and hashes hash functions, both
+// raised to at least one.
+func NewFilter(size, hashes int) *Filter {
+	size = max(size, 1)
+	return &Filter{
+		bits:   make([]uint64, (size+63)/64),
+		size:   uint64(size),
+		hashes: max(hashes, 1),
+	}
+}
+
+func (f *Filter) Add(data []byte) {
+	for _, slot := range locations(data, f.hashes, f.size) {
+		f.bits[slot/64] |= 1 << (slot % 64)
+	}
+}
+
+func (f *Filter) MayContain(data []byte) bool {
+	for _, slot := range locations(data, f.hashes, f.size) {
+		if f.bits[slot/64]&(1<<(slot%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package bloom
+
+import "errors"
+
+var ErrMismatch = errors.New("bloom: filters have different parameters")
+
+func combine(a, b *Filter, op func(x, y uint64) uint64) (*Filter, error) {
+	if a.size != b.size || a.hashes != b.hashes {
+		return nil, ErrMismatch
+	}
+
+	out := &Filter{
+		bits:   make([]uint64, len(a.bits)),
+		size:   a.size,
+		hashes: a.hashes,
+	}
+	i := 0
+	for i < len(a.bits) {
+		out.bits[i] = op(a.bits[i], b.bits[i])
+		i++
+	}
+
+	return out, nil
+}
+
+// Union returns a filter that may contain everything either a or b may
+// contain. It is identical to a filter built by adding both sets of items,
+// so there are still no false negatives.
+func Union(a, b *Filter) (*Filter, error) {
+	return combine(a, b, func(x, y uint64) uint64 {
+		return x | y
+	})
+}
+
+// Intersect returns a filter whose bits are set in both a and b. Every item
+// added to both still tests positive, but an item in only one set can now
+// test positive when its other bits were set by unrelated items in the other
+// filter, so the false-positive rate can exceed that of either input.
+func Intersect(a, b *Filter) (*Filter, error) {
+	return combine(a, b, func(x, y uint64) uint64 {
+		return x & y
+	})
+}
@@ -0,0 +1,104 @@
+package bloom
+
+import (
+	"fmt"
+	"testing"
+)
+
+func keys(prefix string, n int) [][]byte {
+	out := make([][]byte, n)
+	for i := range out {
+		out[i] = []byte(fmt.Sprintf("%s-%d", prefix, i))
+	}
+	return out
+}
+
+func TestUnionContainsBothSets(t *testing.T) {
+	a, b := NewFilter(4096, 4), NewFilter(4096, 4)
+	left, right := keys("left", 200), keys("right", 200)
+	for _, k := range left {
+		a.Add(k)
+	}
+	for _, k := range right {
+		b.Add(k)
+	}
+
+	u, err := Union(a, b)
+	if err != nil {
+		t.Fatalf("Union: %v", err)
+	}
+	for _, k := range append(left, right...) {
+		if !u.MayContain(k) {
+			t.Errorf("union lost %q", k)
+		}
+	}
+
+	// The union is exactly the filter built from both sets.
+	both := NewFilter(4096, 4)
+	for _, k := range append(left, right...) {
+		both.Add(k)
+	}
+	for i := range both.bits {
+		if both.bits[i] != u.bits[i] {
+			t.Fatalf("union differs from a filter built from both sets at word %d", i)
+		}
+	}
+}
+
+func TestIntersectKeepsCommonItems(t *testing.T) {
+	a, b := NewFilter(4096, 4), NewFilter(4096, 4)
+	common := keys("common", 50)
+	for _, k := range common {
+		a.Add(k)
+		b.Add(k)
+	}
+	for _, k := range keys("only-a", 50) {
+		a.Add(k)
+	}
+
+	i, err := Intersect(a, b)
+	if err != nil {
+		t.Fatalf("Intersect: %v", err)
+	}
+	for _, k := range common {
+		if !i.MayContain(k) {
+			t.Errorf("intersection lost common item %q", k)
+		}
+	}
+}
+
+func TestSetOperationsMismatch(t *testing.T) {
+	base := NewFilter(1024, 3)
+	for _, other := range []*Filter{NewFilter(2048, 3), NewFilter(1024, 4)} {
+		if _, err := Union(base, other); err != ErrMismatch {
+			t.Errorf("Union: err = %v, want ErrMismatch", err)
+		}
+		if _, err := Intersect(base, other); err != ErrMismatch {
+			t.Errorf("Intersect: err = %v, want ErrMismatch", err)
+		}
+	}
+}
+
+func TestFilterNoFalseNegatives(t *testing.T) {
+	f := NewFilter(1000, 5)
+	added := keys("item", 100)
+	for _, k := range added {
+		f.Add(k)
+	}
+	for _, k := range added {
+		if !f.MayContain(k) {
+			t.Errorf("MayContain(%q) = false after Add", k)
+		}
+	}
+
+	falsePositives := 0
+	for _, k := range keys("absent", 1000) {
+		if f.MayContain(k) {
+			falsePositives++
+		}
+	}
+	// About 1% is expected at this load; allow plenty of slack.
+	if falsePositives > 50 {
+		t.Errorf("%d of 1000 absent items test positive", falsePositives)
+	}
+}
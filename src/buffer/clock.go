@@ -0,0 +1,18 @@
+package buffer
+
+import "time"
+
+type Timer interface {
+	Stop() bool
+}
+
+// Clock lets tests drive time-triggered behaviour without sleeping.
+type Clock interface {
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+type systemClock struct{}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
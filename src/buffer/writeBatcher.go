@@ -0,0 +1,101 @@
+package buffer
+
+import (
+	"sync"
+	"time"
+)
+
+// WriteBatcher collects items and hands them to flush in insertion order once
+// size items are pending or interval has passed since the first pending item,
+// whichever comes first. Flushes are serialized and run with the batcher
+// locked, so flush must not call back into the batcher.
+type WriteBatcher[T any] struct {
+	mu         sync.Mutex
+	size       int
+	interval   time.Duration
+	flush      func(items []T)
+	clock      Clock
+	pending    []T
+	timer      Timer
+	generation int
+	closed     bool
+}
+
+// A nil clock means the system clock.
+func NewWriteBatcher[T any](size int, interval time.Duration, flush func(items []T), clock Clock) *WriteBatcher[T] {
+	if clock == nil {
+		clock = systemClock{}
+	}
+
+	return &WriteBatcher[T]{
+		size:     size,
+		interval: interval,
+		flush:    flush,
+		clock:    clock,
+	}
+}
+
+// Add panics if the batcher has been closed.
+func (b *WriteBatcher[T]) Add(item T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		panic("buffer: Add on closed WriteBatcher")
+	}
+
+	b.pending = append(b.pending, item)
+
+	if len(b.pending) >= b.size {
+		b.flushLocked()
+		return
+	}
+
+	if len(b.pending) == 1 && b.interval > 0 {
+		generation := b.generation
+		b.timer = b.clock.AfterFunc(b.interval, func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			if b.generation == generation {
+				b.flushLocked()
+			}
+		})
+	}
+}
+
+func (b *WriteBatcher[T]) flushLocked() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	b.generation++
+
+	if len(b.pending) == 0 {
+		return
+	}
+
+	items := b.pending
+	b.pending = nil
+	b.flush(items)
+}
+
+func (b *WriteBatcher[T]) Flush() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.flushLocked()
+}
+
+// Close flushes whatever is still pending. Closing twice is a no-op.
+func (b *WriteBatcher[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.flushLocked()
+	b.closed = true
+}
@@ -0,0 +1,169 @@
+package buffer
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeTimer struct {
+	clock   *fakeClock
+	at      time.Duration
+	f       func()
+	stopped bool
+	fired   bool
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	active := !t.stopped && !t.fired
+	t.stopped = true
+	return active
+}
+
+// fakeClock only fires timers when Advance is called.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Duration
+	timers []*fakeTimer
+}
+
+func (c *fakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := &fakeTimer{clock: c, at: c.now + d, f: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now += d
+	var due []*fakeTimer
+	for _, t := range c.timers {
+		if !t.stopped && !t.fired && t.at <= c.now {
+			t.fired = true
+			due = append(due, t)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, t := range due {
+		t.f()
+	}
+}
+
+type recorder struct {
+	batches [][]int
+}
+
+func (r *recorder) flush(items []int) {
+	r.batches = append(r.batches, append([]int{}, items...))
+}
+
+func TestWriteBatcherSizeTrigger(t *testing.T) {
+	r := &recorder{}
+	b := NewWriteBatcher(3, time.Hour, r.flush, &fakeClock{})
+
+	i := 1
+	for i <= 7 {
+		b.Add(i)
+		i++
+	}
+
+	want := [][]int{{1, 2, 3}, {4, 5, 6}}
+	if !reflect.DeepEqual(r.batches, want) {
+		t.Errorf("batches = %v, want %v", r.batches, want)
+	}
+}
+
+func TestWriteBatcherIntervalTrigger(t *testing.T) {
+	r := &recorder{}
+	clock := &fakeClock{}
+	b := NewWriteBatcher(10, time.Second, r.flush, clock)
+
+	b.Add(1)
+	clock.Advance(500 * time.Millisecond)
+	b.Add(2)
+	if len(r.batches) != 0 {
+		t.Fatalf("flushed before the interval: %v", r.batches)
+	}
+
+	// The interval counts from the first pending item, not the latest.
+	clock.Advance(500 * time.Millisecond)
+	if want := [][]int{{1, 2}}; !reflect.DeepEqual(r.batches, want) {
+		t.Fatalf("batches = %v, want %v", r.batches, want)
+	}
+
+	b.Add(3)
+	clock.Advance(time.Second)
+	if want := [][]int{{1, 2}, {3}}; !reflect.DeepEqual(r.batches, want) {
+		t.Errorf("batches = %v, want %v", r.batches, want)
+	}
+}
+
+func TestWriteBatcherStaleTimer(t *testing.T) {
+	r := &recorder{}
+	clock := &fakeClock{}
+	b := NewWriteBatcher(2, time.Second, r.flush, clock)
+
+	// The first batch fills by size; its timer must not flush the next one
+	// early even if it were to fire.
+	b.Add(1)
+	b.Add(2)
+	b.Add(3)
+	for _, timer := range clock.timers[:1] {
+		timer.f()
+	}
+	if want := [][]int{{1, 2}}; !reflect.DeepEqual(r.batches, want) {
+		t.Errorf("batches = %v, want %v", r.batches, want)
+	}
+}
+
+func TestWriteBatcherClose(t *testing.T) {
+	r := &recorder{}
+	b := NewWriteBatcher(10, time.Hour, r.flush, &fakeClock{})
+
+	b.Add(4)
+	b.Add(5)
+	b.Close()
+	b.Close()
+	if want := [][]int{{4, 5}}; !reflect.DeepEqual(r.batches, want) {
+		t.Errorf("batches = %v, want %v", r.batches, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Add after Close did not panic")
+		}
+	}()
+	b.Add(6)
+}
+
+func TestWriteBatcherFlushEmpty(t *testing.T) {
+	r := &recorder{}
+	b := NewWriteBatcher(10, time.Hour, r.flush, &fakeClock{})
+	b.Flush()
+	if len(r.batches) != 0 {
+		t.Errorf("flush called with nothing pending: %v", r.batches)
+	}
+}
+
+func TestWriteBatcherSystemClock(t *testing.T) {
+	done := make(chan []int, 1)
+	b := NewWriteBatcher(10, 10*time.Millisecond, func(items []int) { done <- items }, nil)
+	b.Add(1)
+
+	select {
+	case items := <-done:
+		if !reflect.DeepEqual(items, []int{1}) {
+			t.Errorf("flushed %v, want [1]", items)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("system clock never flushed")
+	}
+}
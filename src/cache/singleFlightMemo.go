@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrPanicked = errors.New("cache: memoized function panicked")
+
+type flight[V any] struct {
+	done    chan struct{}
+	value   V
+	err     error
+	expires time.Time
+}
+
+// SingleFlightMemo shares one execution of fn among all concurrent callers of
+// Do for the same key and keeps a successful result for ttl. Errors are handed
+// to every waiting caller but are not cached.
+type SingleFlightMemo[K comparable, V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	flights map[K]*flight[V]
+}
+
+func NewSingleFlightMemo[K comparable, V any](ttl time.Duration) *SingleFlightMemo[K, V] {
+	return &SingleFlightMemo[K, V]{
+		ttl:     ttl,
+		flights: map[K]*flight[V]{},
+	}
+}
+
+// Do returns the cached result for key or runs fn to produce it. If fn panics
+// the panic reaches the caller that ran it, the callers waiting on that run
+// get ErrPanicked, and the next call for key runs fn afresh.
+func (m *SingleFlightMemo[K, V]) Do(key K, fn func() (V, error)) (V, error) {
+	m.mu.Lock()
+	if f, ok := m.flights[key]; ok {
+		select {
+		case <-f.done:
+			if time.Now().Before(f.expires) {
+				m.mu.Unlock()
+				return f.value, nil
+			}
+		default:
+			m.mu.Unlock()
+			<-f.done
+			return f.value, f.err
+		}
+	}
+
+	f := &flight[V]{done: make(chan struct{})}
+	m.flights[key] = f
+	m.mu.Unlock()
+
+	returned := false
+	defer func() {
+		if returned {
+			return
+		}
+		m.mu.Lock()
+		f.err = ErrPanicked
+		delete(m.flights, key)
+		close(f.done)
+		m.mu.Unlock()
+	}()
+
+	f.value, f.err = fn()
+	returned = true
+
+	m.mu.Lock()
+	if f.err != nil {
+		delete(m.flights, key)
+	} else {
+		f.expires = time.Now().Add(m.ttl)
+	}
+	close(f.done)
+	m.mu.Unlock()
+
+	return f.value, f.err
+}
+
+// Forget drops any cached result for key. A call already in flight is not
+// affected.
+func (m *SingleFlightMemo[K, V]) Forget(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if f, ok := m.flights[key]; ok {
+		select {
+		case <-f.done:
+			delete(m.flights, key)
+		default:
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSingleFlightMemoSharesExecution(t *testing.T) {
+	m := NewSingleFlightMemo[string, int](time.Minute)
+	var runs int64
+	start := make(chan struct{})
+
+	var wg sync.WaitGroup
+	results := make([]int, 50)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			v, err := m.Do("k", func() (int, error) {
+				atomic.AddInt64(&runs, 1)
+				time.Sleep(50 * time.Millisecond)
+				return 42, nil
+			})
+			if err != nil {
+				t.Errorf("Do: %v", err)
+			}
+			results[i] = v
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	if runs != 1 {
+		t.Errorf("fn ran %d times, want 1", runs)
+	}
+	for i, v := range results {
+		if v != 42 {
+			t.Errorf("caller %d got %d, want 42", i, v)
+		}
+	}
+}
+
+func TestSingleFlightMemoTTL(t *testing.T) {
+	m := NewSingleFlightMemo[string, int](20 * time.Millisecond)
+	runs := 0
+	fn := func() (int, error) {
+		runs++
+		return runs, nil
+	}
+
+	if v, _ := m.Do("k", fn); v != 1 {
+		t.Fatalf("first Do = %d, want 1", v)
+	}
+	if v, _ := m.Do("k", fn); v != 1 || runs != 1 {
+		t.Errorf("cached Do = %d after %d runs, want 1 after 1", v, runs)
+	}
+
+	time.Sleep(40 * time.Millisecond)
+	if v, _ := m.Do("k", fn); v != 2 || runs != 2 {
+		t.Errorf("Do after expiry = %d after %d runs, want 2 after 2", v, runs)
+	}
+
+	m.Forget("k")
+	if v, _ := m.Do("k", fn); v != 3 {
+		t.Errorf("Do after Forget = %d, want 3", v)
+	}
+}
+
+func TestSingleFlightMemoErrorsNotCached(t *testing.T) {
+	m := NewSingleFlightMemo[string, int](time.Minute)
+	boom := errors.New("boom")
+
+	if _, err := m.Do("k", func() (int, error) { return 0, boom }); err != boom {
+		t.Fatalf("Do = %v, want %v", err, boom)
+	}
+	if v, err := m.Do("k", func() (int, error) { return 7, nil }); err != nil || v != 7 {
+		t.Errorf("Do after an error = %d, %v, want 7, nil", v, err)
+	}
+}
+
+func TestSingleFlightMemoPanic(t *testing.T) {
+	m := NewSingleFlightMemo[string, int](time.Minute)
+	entered := make(chan struct{})
+	proceed := make(chan struct{})
+
+	panicked := make(chan any, 1)
+	go func() {
+		defer func() { panicked <- recover() }()
+		m.Do("k", func() (int, error) {
+			close(entered)
+			<-proceed
+			panic("boom")
+		})
+	}()
+
+	<-entered
+	waiter := make(chan error, 1)
+	go func() {
+		_, err := m.Do("k", func() (int, error) { return 1, nil })
+		waiter <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(proceed)
+
+	if p := <-panicked; p != "boom" {
+		t.Errorf("panic value = %v, want boom", p)
+	}
+	select {
+	case err := <-waiter:
+		// The waiter either joined the failed run or started after it.
+		if err != ErrPanicked && err != nil {
+			t.Errorf("waiter err = %v, want ErrPanicked", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("waiter hung after fn panicked")
+	}
+
+	if v, err := m.Do("k", func() (int, error) { return 9, nil }); err != nil || (v != 9 && v != 1) {
+		t.Errorf("Do after a panic = %d, %v", v, err)
+	}
+}
@@ -0,0 +1,88 @@
+package cache
+
+import "container/list"
+
+type weightedEntry[K comparable, V any] struct {
+	key    K
+	value  V
+	weight int
+}
+
+// WeightedLRU evicts least-recently-used entries until the total weight, as
+// reported by the weigher, fits within capacity.
+type WeightedLRU[K comparable, V any] struct {
+	capacity int
+	weight   int
+	weigher  func(key K, value V) int
+	order    *list.List
+	entries  map[K]*list.Element
+}
+
+func NewWeightedLRU[K comparable, V any](capacity int, weigher func(key K, value V) int) *WeightedLRU[K, V] {
+	return &WeightedLRU[K, V]{
+		capacity: capacity,
+		weigher:  weigher,
+		order:    list.New(),
+		entries:  map[K]*list.Element{},
+	}
+}
+
+func (c *WeightedLRU[K, V]) Get(key K) (V, bool) {
+	element, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	c.order.MoveToFront(element)
+	return element.Value.(*weightedEntry[K, V]).value, true
+}
+
+// Put inserts or replaces key and marks it most recently used. An entry that
+// weighs more than the whole capacity is rejected: Put returns false and the
+// cache, including any previous value for key, is left untouched.
+func (c *WeightedLRU[K, V]) Put(key K, value V) bool {
+	weight := c.weigher(key, value)
+	if weight > c.capacity {
+		return false
+	}
+
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*weightedEntry[K, V])
+		c.weight += weight - entry.weight
+		entry.value = value
+		entry.weight = weight
+		c.order.MoveToFront(element)
+	} else {
+		c.entries[key] = c.order.PushFront(&weightedEntry[K, V]{key: key, value: value, weight: weight})
+		c.weight += weight
+	}
+
+	for c.weight > c.capacity {
+		c.removeElement(c.order.Back())
+	}
+
+	return true
+}
+
+func (c *WeightedLRU[K, V]) Remove(key K) bool {
+	element, ok := c.entries[key]
+	if ok {
+		c.removeElement(element)
+	}
+	return ok
+}
+
+func (c *WeightedLRU[K, V]) removeElement(element *list.Element) {
+	entry := c.order.Remove(element).(*weightedEntry[K, V])
+	delete(c.entries, entry.key)
+	c.weight -= entry.weight
+}
+
+func (c *WeightedLRU[K, V]) Len() int {
+	return len(c.entries)
+}
+
+func (c *WeightedLRU[K, V]) Weight() int {
+	return c.weight
+}
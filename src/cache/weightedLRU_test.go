@@ -0,0 +1,86 @@
+package cache
+
+import "testing"
+
+func byteWeight(_ string, value []byte) int { return len(value) }
+
+func TestWeightedLRUEviction(t *testing.T) {
+	c := NewWeightedLRU[string, []byte](10, byteWeight)
+
+	c.Put("a", make([]byte, 3))
+	c.Put("b", make([]byte, 4))
+	c.Put("c", make([]byte, 2))
+	if c.Weight() != 9 || c.Len() != 3 {
+		t.Fatalf("Weight = %d, Len = %d, want 9 and 3", c.Weight(), c.Len())
+	}
+
+	// Touch a so that b becomes the least recently used entry.
+	c.Get("a")
+
+	// Six more bytes need 5 freed: evicting b alone frees 4, so c goes too.
+	if !c.Put("d", make([]byte, 6)) {
+		t.Fatalf("Put(d) rejected")
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("b survived eviction")
+	}
+	if _, ok := c.Get("c"); ok {
+		t.Errorf("c survived eviction")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Errorf("recently used a was evicted")
+	}
+	if c.Weight() != 9 || c.Len() != 2 {
+		t.Errorf("Weight = %d, Len = %d, want 9 and 2", c.Weight(), c.Len())
+	}
+}
+
+func TestWeightedLRUReplaceChangesWeight(t *testing.T) {
+	c := NewWeightedLRU[string, []byte](10, byteWeight)
+	c.Put("a", make([]byte, 2))
+	c.Put("b", make([]byte, 2))
+
+	c.Put("b", make([]byte, 9))
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("a survived growing b to 9")
+	}
+	if v, ok := c.Get("b"); !ok || len(v) != 9 || c.Weight() != 9 {
+		t.Errorf("Get(b) = %d bytes, %v with Weight %d, want 9 bytes", len(v), ok, c.Weight())
+	}
+
+	c.Put("b", make([]byte, 1))
+	if c.Weight() != 1 {
+		t.Errorf("Weight after shrinking b = %d, want 1", c.Weight())
+	}
+}
+
+func TestWeightedLRURejectsOversized(t *testing.T) {
+	c := NewWeightedLRU[string, []byte](10, byteWeight)
+	c.Put("a", make([]byte, 5))
+
+	if c.Put("big", make([]byte, 11)) {
+		t.Errorf("Put accepted an entry heavier than the capacity")
+	}
+	if c.Put("a", make([]byte, 11)) {
+		t.Errorf("Put accepted an oversized replacement")
+	}
+	if v, ok := c.Get("a"); !ok || len(v) != 5 || c.Weight() != 5 || c.Len() != 1 {
+		t.Errorf("cache changed by rejected Puts: Get(a) = %d bytes, %v, Weight %d, Len %d", len(v), ok, c.Weight(), c.Len())
+	}
+
+	if !c.Put("exact", make([]byte, 10)) || c.Len() != 1 || c.Weight() != 10 {
+		t.Errorf("an entry of exactly the capacity should evict everything else")
+	}
+}
+
+func TestWeightedLRURemove(t *testing.T) {
+	c := NewWeightedLRU[string, []byte](10, byteWeight)
+	c.Put("a", make([]byte, 4))
+
+	if !c.Remove("a") || c.Remove("a") {
+		t.Errorf("Remove should succeed once")
+	}
+	if c.Weight() != 0 || c.Len() != 0 {
+		t.Errorf("Weight = %d, Len = %d after Remove, want 0", c.Weight(), c.Len())
+	}
+}
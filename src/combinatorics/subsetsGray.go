@@ -0,0 +1,32 @@
+package combinatorics
+
+import "math/bits"
+
+// SubsetsGray returns all 2^n subsets of s, starting with the empty set, in
+// binary-reflected Gray code order: each subset adds or removes exactly one
+// element of the previous one. Elements within a subset keep their order in
+// s. The result grows exponentially with len(s).
+func SubsetsGray[T any](s []T) [][]T {
+	n := len(s)
+	subsets := make([][]T, 0, 1<<n)
+	subsets = append(subsets, []T{})
+
+	var code uint64
+	i := uint64(1)
+	for i < 1<<n {
+		code ^= 1 << bits.TrailingZeros64(i)
+
+		subset := make([]T, 0, bits.OnesCount64(code))
+		j := 0
+		for j < n {
+			if code&(1<<j) != 0 {
+				subset = append(subset, s[j])
+			}
+			j++
+		}
+		subsets = append(subsets, subset)
+		i++
+	}
+
+	return subsets
+}
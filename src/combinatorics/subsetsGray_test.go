@@ -0,0 +1,59 @@
+package combinatorics
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSubsetsGray(t *testing.T) {
+	n := 0
+	for n <= 10 {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = i
+		}
+		subsets := SubsetsGray(s)
+
+		if len(subsets) != 1<<n {
+			t.Fatalf("SubsetsGray(%d elements) returned %d subsets, want %d", n, len(subsets), 1<<n)
+		}
+		if len(subsets[0]) != 0 {
+			t.Fatalf("SubsetsGray(%d elements) starts with %v, want the empty set", n, subsets[0])
+		}
+
+		// Encoded as bitmasks, the subsets must be exactly 0..2^n-1, and
+		// neighbours must differ in one bit.
+		seen := make([]bool, 1<<n)
+		prev := -1
+		for _, subset := range subsets {
+			mask := 0
+			last := -1
+			for _, v := range subset {
+				if v <= last {
+					t.Fatalf("subset %v is not in the order of s", subset)
+				}
+				last = v
+				mask |= 1 << v
+			}
+			if seen[mask] {
+				t.Fatalf("subset %v appears twice", subset)
+			}
+			seen[mask] = true
+
+			if prev >= 0 {
+				if diff := prev ^ mask; diff == 0 || diff&(diff-1) != 0 {
+					t.Fatalf("subset %v does not differ from the previous one by one element", subset)
+				}
+			}
+			prev = mask
+		}
+		n++
+	}
+}
+
+func TestSubsetsGrayElements(t *testing.T) {
+	got := fmt.Sprint(SubsetsGray([]string{"a", "b", "c"}))
+	if want := "[[] [a] [a b] [b] [b c] [a b c] [a c] [c]]"; got != want {
+		t.Errorf("SubsetsGray(a, b, c) = %s, want %s", got, want)
+	}
+}
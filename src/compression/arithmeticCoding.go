@@ -0,0 +1,225 @@
+package compression
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+const (
+	codeTop     = 1<<32 - 1
+	codeHalf    = 1 << 31
+	codeQuarter = 1 << 30
+	modelLimit  = 1 << 24
+
+	// A valid stream never makes the decoder read more than 32 bits beyond
+	// its end, since the decoder starts 32 bits ahead of the encoder.
+	readSlack = 32
+)
+
+var ErrCorruptInput = errors.New("compression: corrupt arithmetic-coded input")
+
+// Both sides derive the same cumulative table from freqs. Symbols with a zero
+// frequency are given a count of one so that any input can be encoded, and
+// large models are scaled down to keep every interval representable.
+func cumulativeFrequencies(freqs [256]uint32) [257]uint64 {
+	var total uint64
+	for _, f := range freqs {
+		total += uint64(f)
+	}
+
+	var cum [257]uint64
+	i := 0
+	for i < 256 {
+		f := uint64(freqs[i])
+		if total > modelLimit {
+			f = f * modelLimit / total
+		}
+		if f == 0 {
+			f = 1
+		}
+		cum[i+1] = cum[i] + f
+		i++
+	}
+
+	return cum
+}
+
+type bitWriter struct {
+	out   []byte
+	cur   byte
+	count uint
+}
+
+func (w *bitWriter) write(bit uint64) {
+	w.cur = w.cur<<1 | byte(bit)
+	w.count++
+	if w.count == 8 {
+		w.out = append(w.out, w.cur)
+		w.cur, w.count = 0, 0
+	}
+}
+
+func (w *bitWriter) writeWithPending(bit uint64, pending *int) {
+	w.write(bit)
+	for *pending > 0 {
+		w.write(bit ^ 1)
+		*pending--
+	}
+}
+
+func (w *bitWriter) bytes() []byte {
+	if w.count > 0 {
+		w.out = append(w.out, w.cur<<(8-w.count))
+		w.cur, w.count = 0, 0
+	}
+	return w.out
+}
+
+type bitReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *bitReader) overrun() bool {
+	return r.pos > 8*len(r.data)+readSlack
+}
+
+// read returns zeros past the end of the data but keeps counting, so that
+// overrun can tell a stream that has run dry.
+func (r *bitReader) read() uint64 {
+	pos := r.pos
+	r.pos++
+	if pos >= 8*len(r.data) {
+		return 0
+	}
+	return uint64(r.data[pos/8] >> (7 - uint(pos%8)) & 1)
+}
+
+// The encoded stream starts with the input length as a uvarint, so the
+// decoder knows how many symbols to produce.
+func ArithmeticEncode(data []byte, freqs [256]uint32) []byte {
+	cum := cumulativeFrequencies(freqs)
+	total := cum[256]
+
+	w := &bitWriter{out: binary.AppendUvarint(nil, uint64(len(data)))}
+	var low, high uint64 = 0, codeTop
+	pending := 0
+
+	for _, c := range data {
+		symbol := int(c)
+		width := high - low + 1
+		high = low + width*cum[symbol+1]/total - 1
+		low = low + width*cum[symbol]/total
+
+		for {
+			if high < codeHalf {
+				w.writeWithPending(0, &pending)
+			} else if low >= codeHalf {
+				w.writeWithPending(1, &pending)
+				low -= codeHalf
+				high -= codeHalf
+			} else if low >= codeQuarter && high < 3*codeQuarter {
+				pending++
+				low -= codeQuarter
+				high -= codeQuarter
+			} else {
+				break
+			}
+			low = 2 * low
+			high = 2*high + 1
+		}
+	}
+
+	if len(data) > 0 {
+		pending++
+		if low < codeQuarter {
+			w.writeWithPending(0, &pending)
+		} else {
+			w.writeWithPending(1, &pending)
+		}
+	}
+
+	return w.bytes()
+}
+
+// maxDecodedLength bounds how many symbols payloadBits bits can encode under
+// the model: even the most likely symbol costs -log2(p) bits. The bound is
+// loosened slightly for the rounding of interval widths.
+func maxDecodedLength(cum [257]uint64, payloadBits int) uint64 {
+	var largest uint64
+	i := 0
+	for i < 256 {
+		largest = max(largest, cum[i+1]-cum[i])
+		i++
+	}
+
+	bitsPerSymbol := -math.Log2(float64(largest) / float64(cum[256]))
+	return uint64(float64(payloadBits+readSlack)/(0.99*bitsPerSymbol)) + 1
+}
+
+// ArithmeticDecode reverses ArithmeticEncode under the same model. Input that
+// claims more symbols than it could hold, or that runs out before all of them
+// are decoded, yields ErrCorruptInput.
+func ArithmeticDecode(encoded []byte, freqs [256]uint32) ([]byte, error) {
+	length, n := binary.Uvarint(encoded)
+	if n <= 0 {
+		return nil, ErrCorruptInput
+	}
+
+	cum := cumulativeFrequencies(freqs)
+	total := cum[256]
+	if length > maxDecodedLength(cum, 8*(len(encoded)-n)) {
+		return nil, ErrCorruptInput
+	}
+
+	r := &bitReader{data: encoded[n:]}
+	var low, high, value uint64 = 0, codeTop, 0
+
+	i := 0
+	for i < 32 {
+		value = value<<1 | r.read()
+		i++
+	}
+
+	var out []byte
+	for uint64(len(out)) < length {
+		if value < low || value > high || r.overrun() {
+			return nil, ErrCorruptInput
+		}
+
+		width := high - low + 1
+		scaled := ((value-low+1)*total - 1) / width
+
+		symbol := 0
+		for cum[symbol+1] <= scaled {
+			symbol++
+		}
+		out = append(out, byte(symbol))
+
+		high = low + width*cum[symbol+1]/total - 1
+		low = low + width*cum[symbol]/total
+
+	renormalize:
+		for {
+			switch {
+			case high < codeHalf:
+			case low >= codeHalf:
+				low -= codeHalf
+				high -= codeHalf
+				value -= codeHalf
+			case low >= codeQuarter && high < 3*codeQuarter:
+				low -= codeQuarter
+				high -= codeQuarter
+				value -= codeQuarter
+			default:
+				break renormalize
+			}
+			low = 2 * low
+			high = 2*high + 1
+			value = value<<1 | r.read()
+		}
+	}
+
+	return out, nil
+}
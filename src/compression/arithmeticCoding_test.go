@@ -0,0 +1,88 @@
+package compression
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"testing"
+)
+
+func frequenciesOf(data []byte) [256]uint32 {
+	var freqs [256]uint32
+	for _, c := range data {
+		freqs[c]++
+	}
+	return freqs
+}
+
+func TestArithmeticRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 300; i++ {
+		data := make([]byte, r.Intn(2000))
+		for j := range data {
+			if i%2 == 0 && r.Intn(10) < 9 {
+				data[j] = 'a'
+			} else {
+				data[j] = byte(r.Intn(256))
+			}
+		}
+
+		// Alternate between a model fitted to the data and an unrelated one,
+		// which still has to work since zero counts are bumped to one.
+		freqs := frequenciesOf(data)
+		if i%3 == 0 {
+			freqs = [256]uint32{'z': 1 << 30}
+		}
+
+		encoded := ArithmeticEncode(data, freqs)
+		decoded, err := ArithmeticDecode(encoded, freqs)
+		if err != nil || !bytes.Equal(decoded, data) {
+			t.Fatalf("round trip of %d bytes failed: %v", len(data), err)
+		}
+	}
+}
+
+func TestArithmeticEmpty(t *testing.T) {
+	var freqs [256]uint32
+	decoded, err := ArithmeticDecode(ArithmeticEncode(nil, freqs), freqs)
+	if err != nil || len(decoded) != 0 {
+		t.Fatalf("empty round trip = %v, %v", decoded, err)
+	}
+}
+
+// Huffman coding spends at least one bit per symbol, so on a heavily skewed
+// source arithmetic coding must beat len(data)/8 bytes.
+func TestArithmeticBeatsHuffmanOnSkewedInput(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	data := make([]byte, 10000)
+	for i := range data {
+		if r.Intn(100) == 0 {
+			data[i] = 'b'
+		} else {
+			data[i] = 'a'
+		}
+	}
+
+	encoded := ArithmeticEncode(data, frequenciesOf(data))
+	if huffmanFloor := len(data) / 8; len(encoded) >= huffmanFloor {
+		t.Fatalf("encoded to %d bytes, Huffman needs at least %d", len(encoded), huffmanFloor)
+	}
+}
+
+func TestArithmeticCorruptInput(t *testing.T) {
+	freqs := [256]uint32{'a': 1000}
+
+	if _, err := ArithmeticDecode(nil, freqs); err != ErrCorruptInput {
+		t.Errorf("empty input: err = %v, want ErrCorruptInput", err)
+	}
+
+	huge := append(binary.AppendUvarint(nil, 1<<40), 0xab, 0xcd)
+	if _, err := ArithmeticDecode(huge, freqs); err != ErrCorruptInput {
+		t.Errorf("oversized length: err = %v, want ErrCorruptInput", err)
+	}
+
+	truncated := ArithmeticEncode(bytes.Repeat([]byte("abc"), 1000), frequenciesOf([]byte("abc")))
+	if _, err := ArithmeticDecode(truncated[:len(truncated)/4], frequenciesOf([]byte("abc"))); err != ErrCorruptInput {
+		t.Errorf("truncated input: err = %v, want ErrCorruptInput", err)
+	}
+}
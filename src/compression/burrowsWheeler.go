@@ -0,0 +1,111 @@
+package compression
+
+import "sort"
+
+func rotationOrder(s string) []int {
+	n := len(s)
+	order := make([]int, n)
+	rank := make([]int, n)
+
+	i := 0
+	for i < n {
+		order[i] = i
+		rank[i] = int(s[i])
+		i++
+	}
+
+	k := 1
+	for {
+		key := func(i int) (int, int) {
+			return rank[i], rank[(i+k)%n]
+		}
+
+		sort.SliceStable(order, func(a, b int) bool {
+			a1, a2 := key(order[a])
+			b1, b2 := key(order[b])
+			if a1 != b1 {
+				return a1 < b1
+			}
+			return a2 < b2
+		})
+
+		newRank := make([]int, n)
+		i = 1
+		for i < n {
+			p1, p2 := key(order[i-1])
+			c1, c2 := key(order[i])
+			newRank[order[i]] = newRank[order[i-1]]
+			if p1 != c1 || p2 != c2 {
+				newRank[order[i]]++
+			}
+			i++
+		}
+		rank = newRank
+
+		if k >= n {
+			break
+		}
+		k *= 2
+	}
+
+	return order
+}
+
+func BWT(s string) (transformed string, index int) {
+	n := len(s)
+	if n == 0 {
+		return "", 0
+	}
+
+	order := rotationOrder(s)
+	out := make([]byte, n)
+
+	i := 0
+	for i < n {
+		out[i] = s[(order[i]+n-1)%n]
+		if order[i] == 0 {
+			index = i
+		}
+		i++
+	}
+
+	return string(out), index
+}
+
+func InverseBWT(transformed string, index int) string {
+	n := len(transformed)
+	if n == 0 {
+		return ""
+	}
+
+	var counts [256]int
+	occurrence := make([]int, n)
+
+	i := 0
+	for i < n {
+		occurrence[i] = counts[transformed[i]]
+		counts[transformed[i]]++
+		i++
+	}
+
+	var first [256]int
+	total := 0
+	c := 0
+	for c < 256 {
+		first[c] = total
+		total += counts[c]
+		c++
+	}
+
+	out := make([]byte, n)
+	row := index
+
+	i = n - 1
+	for i >= 0 {
+		out[i] = transformed[row]
+		row = first[transformed[row]] + occurrence[row]
+		i--
+	}
+
+	return string(out)
+}
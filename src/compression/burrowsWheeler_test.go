@@ -0,0 +1,33 @@
+package compression
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBWTRoundTrip(t *testing.T) {
+	cases := []string{"", "a", "aaaa", "banana", "abracadabra", "mississippi"}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		b := make([]byte, r.Intn(50))
+		for j := range b {
+			b[j] = "ab\x00\xff"[r.Intn(4)]
+		}
+		cases = append(cases, string(b))
+	}
+
+	for _, s := range cases {
+		transformed, index := BWT(s)
+		if got := InverseBWT(transformed, index); got != s {
+			t.Fatalf("InverseBWT(BWT(%q)) = %q", s, got)
+		}
+	}
+}
+
+func TestBWTKnown(t *testing.T) {
+	transformed, index := BWT("banana")
+	if transformed != "nnbaaa" || index != 3 {
+		t.Fatalf("BWT(banana) = %q, %d, want nnbaaa, 3", transformed, index)
+	}
+}
@@ -0,0 +1,53 @@
+package compression
+
+func initialAlphabet() [256]byte {
+	var alphabet [256]byte
+
+	i := 0
+	for i < 256 {
+		alphabet[i] = byte(i)
+		i++
+	}
+
+	return alphabet
+}
+
+func moveToFront(alphabet *[256]byte, position int) {
+	symbol := alphabet[position]
+	copy(alphabet[1:position+1], alphabet[:position])
+	alphabet[0] = symbol
+}
+
+func MTFEncode(data []byte) []byte {
+	alphabet := initialAlphabet()
+	out := make([]byte, len(data))
+
+	i := 0
+	for i < len(data) {
+		position := 0
+		for alphabet[position] != data[i] {
+			position++
+		}
+
+		out[i] = byte(position)
+		moveToFront(&alphabet, position)
+		i++
+	}
+
+	return out
+}
+
+func MTFDecode(data []byte) []byte {
+	alphabet := initialAlphabet()
+	out := make([]byte, len(data))
+
+	i := 0
+	for i < len(data) {
+		position := int(data[i])
+		out[i] = alphabet[position]
+		moveToFront(&alphabet, position)
+		i++
+	}
+
+	return out
+}
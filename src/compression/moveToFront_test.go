@@ -0,0 +1,40 @@
+package compression
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func FuzzMTFRoundTrip(f *testing.F) {
+	every := make([]byte, 256)
+	for i := range every {
+		every[i] = byte(i)
+	}
+	transformed, _ := BWT(strings.Repeat("banana bandana ", 20))
+
+	f.Add([]byte{})
+	f.Add([]byte{0x7f})
+	f.Add(every)
+	f.Add([]byte(transformed))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if got := MTFDecode(MTFEncode(data)); !bytes.Equal(got, data) {
+			t.Fatalf("MTFDecode(MTFEncode(%v)) = %v", data, got)
+		}
+	})
+}
+
+func TestMTFAfterBWTProducesZeros(t *testing.T) {
+	input := strings.Repeat("the quick brown fox ", 50)
+	transformed, _ := BWT(input)
+	encoded := MTFEncode([]byte(transformed))
+
+	zeros := bytes.Count(encoded, []byte{0})
+	if zeros < len(encoded)/2 {
+		t.Fatalf("only %d of %d bytes are zero after BWT+MTF", zeros, len(encoded))
+	}
+	if raw := bytes.Count(MTFEncode([]byte(input)), []byte{0}); raw >= zeros {
+		t.Fatalf("BWT did not help: %d zeros without it, %d with", raw, zeros)
+	}
+}
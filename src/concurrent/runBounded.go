@@ -0,0 +1,59 @@
+package concurrent
+
+import (
+	"context"
+	"sync"
+)
+
+// RunBounded runs tasks with at most limit of them in flight. The first error
+// cancels the context shared by the tasks, stops further tasks from starting
+// and is returned once every started task has finished. If ctx itself ends
+// before all tasks have started, its error is returned instead.
+func RunBounded(ctx context.Context, limit int, tasks []func(context.Context) error) error {
+	if limit < 1 {
+		limit = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := NewSemaphore(limit)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	var launchErr error
+	for _, task := range tasks {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			launchErr = err
+			break
+		}
+		// Acquire succeeds without looking at ctx when a permit is free, so a
+		// failure that has already cancelled ctx has to be checked for here.
+		if err := ctx.Err(); err != nil {
+			sem.Release(1)
+			launchErr = err
+			break
+		}
+
+		wg.Add(1)
+		go func(task func(context.Context) error) {
+			defer wg.Done()
+			defer sem.Release(1)
+
+			if err := task(ctx); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(task)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return launchErr
+}
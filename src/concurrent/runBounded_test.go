@@ -0,0 +1,102 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunBoundedRespectsLimit(t *testing.T) {
+	const limit = 3
+	var active, peak, done int64
+
+	tasks := make([]func(context.Context) error, 40)
+	for i := range tasks {
+		tasks[i] = func(context.Context) error {
+			now := atomic.AddInt64(&active, 1)
+			for {
+				old := atomic.LoadInt64(&peak)
+				if now <= old || atomic.CompareAndSwapInt64(&peak, old, now) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt64(&active, -1)
+			atomic.AddInt64(&done, 1)
+			return nil
+		}
+	}
+
+	if err := RunBounded(context.Background(), limit, tasks); err != nil {
+		t.Fatalf("RunBounded: %v", err)
+	}
+	if peak > limit {
+		t.Errorf("peak concurrency = %d, limit %d", peak, limit)
+	}
+	if peak < 2 {
+		t.Errorf("peak concurrency = %d, tasks never overlapped", peak)
+	}
+	if done != int64(len(tasks)) {
+		t.Errorf("%d tasks completed, want %d", done, len(tasks))
+	}
+}
+
+func TestRunBoundedFirstErrorCancels(t *testing.T) {
+	boom := errors.New("boom")
+	var started, cancelled int64
+
+	tasks := make([]func(context.Context) error, 20)
+	tasks[0] = func(context.Context) error {
+		atomic.AddInt64(&started, 1)
+		return boom
+	}
+	i := 1
+	for i < len(tasks) {
+		tasks[i] = func(ctx context.Context) error {
+			atomic.AddInt64(&started, 1)
+			select {
+			case <-ctx.Done():
+				atomic.AddInt64(&cancelled, 1)
+				return ctx.Err()
+			case <-time.After(5 * time.Second):
+				return nil
+			}
+		}
+		i++
+	}
+
+	if err := RunBounded(context.Background(), 2, tasks); err != boom {
+		t.Errorf("RunBounded = %v, want %v", err, boom)
+	}
+	// Only the failing task and at most the one running beside it start.
+	if started > 2 {
+		t.Errorf("%d tasks started after the failure, want at most 2", started)
+	}
+	if started-1 != cancelled {
+		t.Errorf("%d of %d other started tasks saw the cancellation", cancelled, started-1)
+	}
+}
+
+func TestRunBoundedParentContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var ran int64
+	tasks := []func(context.Context) error{
+		func(context.Context) error { atomic.AddInt64(&ran, 1); return nil },
+	}
+	if err := RunBounded(ctx, 1, tasks); err != context.Canceled {
+		t.Errorf("RunBounded(cancelled ctx) = %v, want context.Canceled", err)
+	}
+	if ran != 0 {
+		t.Errorf("%d tasks ran under a cancelled context", ran)
+	}
+}
+
+func TestRunBoundedEmpty(t *testing.T) {
+	if err := RunBounded(context.Background(), 4, nil); err != nil {
+		t.Errorf("RunBounded(nil) = %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package concurrent
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+type waiter struct {
+	n     int
+	ready chan struct{}
+}
+
+// Semaphore hands out permits to waiters in FIFO order, so a large request is
+// not starved by a stream of smaller ones.
+type Semaphore struct {
+	mu       sync.Mutex
+	capacity int
+	used     int
+	waiters  list.List
+}
+
+func NewSemaphore(capacity int) *Semaphore {
+	return &Semaphore{capacity: capacity}
+}
+
+// Acquire blocks until n permits are available or ctx is done, in which case
+// ctx.Err() is returned and nothing is acquired. Asking for more than the
+// capacity can only end through ctx.
+func (s *Semaphore) Acquire(ctx context.Context, n int) error {
+	s.mu.Lock()
+	if s.capacity-s.used >= n && s.waiters.Len() == 0 {
+		s.used += n
+		s.mu.Unlock()
+		return nil
+	}
+
+	w := waiter{n: n, ready: make(chan struct{})}
+	element := s.waiters.PushBack(w)
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		select {
+		case <-w.ready:
+			// Permits were granted while we were giving up; hand them back.
+			s.used -= n
+			s.notifyWaiters()
+		default:
+			front := s.waiters.Front() == element
+			s.waiters.Remove(element)
+			if front {
+				s.notifyWaiters()
+			}
+		}
+		s.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (s *Semaphore) TryAcquire(n int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.capacity-s.used >= n && s.waiters.Len() == 0 {
+		s.used += n
+		return true
+	}
+	return false
+}
+
+// Release panics if more permits are released than are held.
+func (s *Semaphore) Release(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.used -= n
+	if s.used < 0 {
+		panic("concurrent: semaphore released more than held")
+	}
+	s.notifyWaiters()
+}
+
+func (s *Semaphore) notifyWaiters() {
+	for {
+		front := s.waiters.Front()
+		if front == nil {
+			return
+		}
+
+		w := front.Value.(waiter)
+		if s.capacity-s.used < w.n {
+			return
+		}
+
+		s.used += w.n
+		s.waiters.Remove(front)
+		close(w.ready)
+	}
+}
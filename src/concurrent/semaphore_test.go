@@ -0,0 +1,141 @@
+package concurrent
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSemaphoreBlocksUntilRelease(t *testing.T) {
+	s := NewSemaphore(3)
+	if err := s.Acquire(context.Background(), 2); err != nil {
+		t.Fatalf("Acquire(2): %v", err)
+	}
+
+	acquired := make(chan error, 1)
+	go func() { acquired <- s.Acquire(context.Background(), 2) }()
+
+	select {
+	case <-acquired:
+		t.Fatalf("Acquire(2) succeeded with only 1 permit free")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	s.Release(2)
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Errorf("Acquire after Release: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Acquire still blocked after Release")
+	}
+}
+
+func TestSemaphoreContextCancel(t *testing.T) {
+	s := NewSemaphore(1)
+	s.Acquire(context.Background(), 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	acquired := make(chan error, 1)
+	go func() { acquired <- s.Acquire(ctx, 1) }()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-acquired:
+		if err != context.Canceled {
+			t.Errorf("Acquire = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("cancellation did not unblock Acquire")
+	}
+
+	// The cancelled waiter must not hold on to anything.
+	s.Release(1)
+	if !s.TryAcquire(1) {
+		t.Errorf("permit lost to a cancelled waiter")
+	}
+}
+
+func TestSemaphoreDeadline(t *testing.T) {
+	s := NewSemaphore(2)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := s.Acquire(ctx, 3); err != context.DeadlineExceeded {
+		t.Errorf("Acquire beyond capacity = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestSemaphoreFIFO(t *testing.T) {
+	s := NewSemaphore(2)
+	s.Acquire(context.Background(), 2)
+
+	big := make(chan error, 1)
+	go func() { big <- s.Acquire(context.Background(), 2) }()
+	time.Sleep(10 * time.Millisecond)
+
+	// A small request behind a queued large one must wait its turn.
+	if s.TryAcquire(1) {
+		t.Errorf("TryAcquire jumped ahead of a queued waiter")
+	}
+
+	s.Release(2)
+	if err := <-big; err != nil {
+		t.Fatalf("queued Acquire: %v", err)
+	}
+}
+
+func TestSemaphoreReleaseTooMany(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Release of unheld permits did not panic")
+		}
+	}()
+	NewSemaphore(1).Release(1)
+}
+
+func TestSemaphoreStress(t *testing.T) {
+	const capacity = 5
+	s := NewSemaphore(capacity)
+	var held, peak int64
+	var wg sync.WaitGroup
+
+	g := 0
+	for g < 50 {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+
+			i := 0
+			for i < 100 {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Duration(i%3)*time.Millisecond)
+				if s.Acquire(ctx, n) == nil {
+					now := atomic.AddInt64(&held, int64(n))
+					for {
+						old := atomic.LoadInt64(&peak)
+						if now <= old || atomic.CompareAndSwapInt64(&peak, old, now) {
+							break
+						}
+					}
+					atomic.AddInt64(&held, -int64(n))
+					s.Release(n)
+				}
+				cancel()
+				i++
+			}
+		}(1 + g%3)
+		g++
+	}
+	wg.Wait()
+
+	if peak > capacity {
+		t.Errorf("%d permits held at once, capacity %d", peak, capacity)
+	}
+	if !s.TryAcquire(capacity) {
+		t.Errorf("permits leaked: cannot acquire the full capacity afterwards")
+	}
+}
@@ -0,0 +1,111 @@
+package concurrent
+
+import "sync/atomic"
+
+const DEQUE_INITIAL_CAPACITY = 32
+
+type ring[T any] struct {
+	slots []atomic.Pointer[T]
+}
+
+func newRing[T any](capacity int) *ring[T] {
+	return &ring[T]{slots: make([]atomic.Pointer[T], capacity)}
+}
+
+func (r *ring[T]) get(i int64) *T {
+	return r.slots[i%int64(len(r.slots))].Load()
+}
+
+func (r *ring[T]) put(i int64, x *T) {
+	r.slots[i%int64(len(r.slots))].Store(x)
+}
+
+// WorkStealingDeque is a Chase-Lev deque. A single owner goroutine pushes and
+// pops at the bottom, LIFO, while any number of thieves steal from the top,
+// FIFO. Push and Pop only synchronise with thieves when one element is left,
+// where owner and thief race for it on a compare-and-swap of top.
+type WorkStealingDeque[T any] struct {
+	top    atomic.Int64
+	bottom atomic.Int64
+	buffer atomic.Pointer[ring[T]]
+}
+
+func NewWorkStealingDeque[T any]() *WorkStealingDeque[T] {
+	d := &WorkStealingDeque[T]{}
+	d.buffer.Store(newRing[T](DEQUE_INITIAL_CAPACITY))
+	return d
+}
+
+// Push adds x at the bottom. Only the owner may call it.
+func (d *WorkStealingDeque[T]) Push(x T) {
+	b := d.bottom.Load()
+	t := d.top.Load()
+	r := d.buffer.Load()
+
+	// Thieves may still be reading the old ring, so it is left untouched and
+	// the live range is copied into a larger one.
+	if b-t >= int64(len(r.slots))-1 {
+		grown := newRing[T](2 * len(r.slots))
+		i := t
+		for i < b {
+			grown.put(i, r.get(i))
+			i++
+		}
+		d.buffer.Store(grown)
+		r = grown
+	}
+
+	r.put(b, &x)
+	d.bottom.Store(b + 1)
+}
+
+// Pop removes the most recently pushed element. Only the owner may call it.
+func (d *WorkStealingDeque[T]) Pop() (T, bool) {
+	var zero T
+
+	b := d.bottom.Load() - 1
+	r := d.buffer.Load()
+	d.bottom.Store(b)
+	t := d.top.Load()
+
+	if t > b {
+		d.bottom.Store(b + 1)
+		return zero, false
+	}
+
+	x := r.get(b)
+	if t < b {
+		return *x, true
+	}
+
+	// Last element: claim it by advancing top, exactly as a thief would.
+	won := d.top.CompareAndSwap(t, t+1)
+	d.bottom.Store(b + 1)
+	if !won {
+		return zero, false
+	}
+	return *x, true
+}
+
+// Steal removes the oldest element. It may be called from any goroutine and
+// returns false only once the deque was seen empty.
+func (d *WorkStealingDeque[T]) Steal() (T, bool) {
+	for {
+		t := d.top.Load()
+		b := d.bottom.Load()
+		if t >= b {
+			var zero T
+			return zero, false
+		}
+
+		x := d.buffer.Load().get(t)
+		if d.top.CompareAndSwap(t, t+1) {
+			return *x, true
+		}
+	}
+}
+
+// Len is a snapshot that may be stale by the time it is used.
+func (d *WorkStealingDeque[T]) Len() int {
+	return int(max(d.bottom.Load()-d.top.Load(), 0))
+}
@@ -0,0 +1,146 @@
+package concurrent
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWorkStealingDequeOrder(t *testing.T) {
+	d := NewWorkStealingDeque[int]()
+	i := 0
+	for i < 100 {
+		d.Push(i)
+		i++
+	}
+	if d.Len() != 100 {
+		t.Fatalf("Len = %d, want 100", d.Len())
+	}
+
+	// Thieves take from the top in FIFO order, the owner from the bottom.
+	if x, ok := d.Steal(); !ok || x != 0 {
+		t.Errorf("Steal = %d, %v, want 0", x, ok)
+	}
+	if x, ok := d.Pop(); !ok || x != 99 {
+		t.Errorf("Pop = %d, %v, want 99", x, ok)
+	}
+	if x, ok := d.Steal(); !ok || x != 1 {
+		t.Errorf("Steal = %d, %v, want 1", x, ok)
+	}
+
+	count := 0
+	for {
+		if _, ok := d.Pop(); !ok {
+			break
+		}
+		count++
+	}
+	if count != 97 || d.Len() != 0 {
+		t.Errorf("popped %d more (Len %d), want 97", count, d.Len())
+	}
+	if _, ok := d.Steal(); ok {
+		t.Errorf("Steal on an empty deque succeeded")
+	}
+	if _, ok := d.Pop(); ok {
+		t.Errorf("Pop on an empty deque succeeded")
+	}
+}
+
+func TestWorkStealingDequeConcurrent(t *testing.T) {
+	const (
+		items   = 200000
+		thieves = 4
+	)
+	d := NewWorkStealingDeque[int]()
+	taken := make([]int32, items)
+	var done atomic.Bool
+
+	var wg sync.WaitGroup
+	thief := 0
+	for thief < thieves {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if x, ok := d.Steal(); ok {
+					atomic.AddInt32(&taken[x], 1)
+				} else if done.Load() && d.Len() == 0 {
+					return
+				}
+			}
+		}()
+		thief++
+	}
+
+	// The owner keeps the deque short, so owner and thieves keep colliding on
+	// the last element, and pops roughly one item for every two it pushes.
+	i := 0
+	for i < items {
+		d.Push(i)
+		if i%2 == 1 {
+			if x, ok := d.Pop(); ok {
+				atomic.AddInt32(&taken[x], 1)
+			}
+		}
+		i++
+	}
+	for {
+		x, ok := d.Pop()
+		if !ok {
+			break
+		}
+		atomic.AddInt32(&taken[x], 1)
+	}
+	done.Store(true)
+	wg.Wait()
+
+	for x, n := range taken {
+		if n != 1 {
+			t.Fatalf("item %d taken %d times, want exactly once", x, n)
+		}
+	}
+}
+
+func TestWorkStealingDequeGrowsUnderThieves(t *testing.T) {
+	d := NewWorkStealingDeque[int]()
+	var stolen atomic.Int64
+	stop := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if _, ok := d.Steal(); ok {
+				stolen.Add(1)
+			}
+		}
+	}()
+
+	// Far more than the initial capacity, so the ring grows while it is
+	// being stolen from.
+	const items = 50 * DEQUE_INITIAL_CAPACITY
+	i := 0
+	for i < items {
+		d.Push(i)
+		i++
+	}
+	close(stop)
+	wg.Wait()
+
+	popped := 0
+	for {
+		if _, ok := d.Pop(); !ok {
+			break
+		}
+		popped++
+	}
+	if got := int(stolen.Load()) + popped; got != items {
+		t.Errorf("recovered %d items, pushed %d", got, items)
+	}
+}
@@ -0,0 +1,82 @@
+package convert
+
+import (
+	"errors"
+	"math"
+)
+
+const DIGITS = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+var (
+	ErrInvalidBase  = errors.New("convert: base must be between 2 and 36")
+	ErrInvalidDigit = errors.New("convert: invalid digit for base")
+)
+
+func validBase(base int) bool {
+	return base >= 2 && base <= len(DIGITS)
+}
+
+// ToBase writes n in the given base using lower-case digits from DIGITS.
+func ToBase(n uint64, base int) (string, error) {
+	if !validBase(base) {
+		return "", ErrInvalidBase
+	}
+	if n == 0 {
+		return "0", nil
+	}
+
+	var out []byte
+	for n > 0 {
+		out = append(out, DIGITS[n%uint64(base)])
+		n /= uint64(base)
+	}
+
+	i, j := 0, len(out)-1
+	for i < j {
+		out[i], out[j] = out[j], out[i]
+		i++
+		j--
+	}
+
+	return string(out), nil
+}
+
+func digitValue(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'z':
+		return int(c-'a') + 10
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10
+	}
+	return -1
+}
+
+// FromBase parses s in the given base; letters may be in either case. It
+// returns ErrInvalidDigit for an empty string or a digit the base lacks, and
+// ErrOutOfRange if the value does not fit in a uint64.
+func FromBase(s string, base int) (uint64, error) {
+	if !validBase(base) {
+		return 0, ErrInvalidBase
+	}
+	if s == "" {
+		return 0, ErrInvalidDigit
+	}
+
+	var n uint64
+	i := 0
+	for i < len(s) {
+		d := digitValue(s[i])
+		if d < 0 || d >= base {
+			return 0, ErrInvalidDigit
+		}
+		if n > (math.MaxUint64-uint64(d))/uint64(base) {
+			return 0, ErrOutOfRange
+		}
+		n = n*uint64(base) + uint64(d)
+		i++
+	}
+
+	return n, nil
+}
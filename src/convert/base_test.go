@@ -0,0 +1,85 @@
+package convert
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestBaseRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(280))
+
+	base := 2
+	for base <= 36 {
+		values := []uint64{0, 1, uint64(base - 1), uint64(base), math.MaxUint64}
+		k := 0
+		for k < 50 {
+			values = append(values, r.Uint64()>>uint(r.Intn(64)))
+			k++
+		}
+
+		for _, n := range values {
+			s, err := ToBase(n, base)
+			if err != nil {
+				t.Fatalf("ToBase(%d, %d): %v", n, base, err)
+			}
+			if want := strconv.FormatUint(n, base); s != want {
+				t.Fatalf("ToBase(%d, %d) = %q, want %q", n, base, s, want)
+			}
+			if got, err := FromBase(s, base); err != nil || got != n {
+				t.Fatalf("FromBase(%q, %d) = %d, %v, want %d", s, base, got, err, n)
+			}
+		}
+		base++
+	}
+}
+
+func TestBaseBoundaries(t *testing.T) {
+	if s, _ := ToBase(10, 2); s != "1010" {
+		t.Errorf("ToBase(10, 2) = %q, want 1010", s)
+	}
+	if s, _ := ToBase(35, 36); s != "z" {
+		t.Errorf("ToBase(35, 36) = %q, want z", s)
+	}
+	if n, err := FromBase("Zz", 36); err != nil || n != 35*36+35 {
+		t.Errorf("FromBase(Zz, 36) = %d, %v, want %d", n, err, 35*36+35)
+	}
+}
+
+func TestBaseErrors(t *testing.T) {
+	for _, base := range []int{-1, 0, 1, 37} {
+		if _, err := ToBase(5, base); err != ErrInvalidBase {
+			t.Errorf("ToBase(5, %d) error = %v, want ErrInvalidBase", base, err)
+		}
+		if _, err := FromBase("1", base); err != ErrInvalidBase {
+			t.Errorf("FromBase(1, %d) error = %v, want ErrInvalidBase", base, err)
+		}
+	}
+
+	digits := []struct {
+		s    string
+		base int
+	}{
+		{"2", 2},
+		{"102", 2},
+		{"a", 10},
+		{"g", 16},
+		{"-1", 10},
+		{"", 10},
+		{" 1", 10},
+	}
+	for _, c := range digits {
+		if _, err := FromBase(c.s, c.base); err != ErrInvalidDigit {
+			t.Errorf("FromBase(%q, %d) error = %v, want ErrInvalidDigit", c.s, c.base, err)
+		}
+	}
+
+	// One more than math.MaxUint64.
+	if _, err := FromBase("18446744073709551616", 10); err != ErrOutOfRange {
+		t.Errorf("FromBase(2^64, 10) error = %v, want ErrOutOfRange", err)
+	}
+	if _, err := FromBase("10000000000000000000000000000000000000000000000000000000000000000", 2); err != ErrOutOfRange {
+		t.Errorf("FromBase(2^64, 2) error = %v, want ErrOutOfRange", err)
+	}
+}
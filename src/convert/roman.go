@@ -0,0 +1,62 @@
+package convert
+
+import "errors"
+
+var (
+	ErrOutOfRange   = errors.New("convert: number out of range")
+	ErrInvalidRoman = errors.New("convert: invalid roman numeral")
+)
+
+var romanValues = []int{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
+var romanSymbols = []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+
+var romanDigits = map[byte]int{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100, 'D': 500, 'M': 1000}
+
+// IntToRoman writes n, which must be in 1..3999, in standard subtractive
+// notation.
+func IntToRoman(n int) (string, error) {
+	if n < 1 || n > 3999 {
+		return "", ErrOutOfRange
+	}
+
+	var out []byte
+	i := 0
+	for n > 0 {
+		for n >= romanValues[i] {
+			out = append(out, romanSymbols[i]...)
+			n -= romanValues[i]
+		}
+		i++
+	}
+
+	return string(out), nil
+}
+
+// RomanToInt parses s, accepting only the canonical form IntToRoman produces,
+// so "IIII", "VV" or "IC" are rejected.
+func RomanToInt(s string) (int, error) {
+	n := 0
+	i := 0
+	for i < len(s) {
+		value, ok := romanDigits[s[i]]
+		if !ok {
+			return 0, ErrInvalidRoman
+		}
+
+		if i+1 < len(s) && romanDigits[s[i+1]] > value {
+			n -= value
+		} else {
+			n += value
+		}
+		i++
+	}
+
+	// Summing accepts many non-canonical strings, so the result is checked by
+	// writing it back out.
+	canonical, err := IntToRoman(n)
+	if err != nil || canonical != s {
+		return 0, ErrInvalidRoman
+	}
+
+	return n, nil
+}
@@ -0,0 +1,54 @@
+package convert
+
+import "testing"
+
+func TestRomanRoundTrip(t *testing.T) {
+	n := 1
+	for n <= 3999 {
+		s, err := IntToRoman(n)
+		if err != nil {
+			t.Fatalf("IntToRoman(%d): %v", n, err)
+		}
+		if got, err := RomanToInt(s); err != nil || got != n {
+			t.Fatalf("RomanToInt(%q) = %d, %v, want %d", s, got, err, n)
+		}
+		n++
+	}
+}
+
+func TestIntToRoman(t *testing.T) {
+	cases := []struct {
+		n    int
+		want string
+	}{
+		{4, "IV"},
+		{9, "IX"},
+		{14, "XIV"},
+		{40, "XL"},
+		{90, "XC"},
+		{400, "CD"},
+		{900, "CM"},
+		{1994, "MCMXCIV"},
+		{3999, "MMMCMXCIX"},
+		{3888, "MMMDCCCLXXXVIII"},
+	}
+	for _, c := range cases {
+		if got, err := IntToRoman(c.n); err != nil || got != c.want {
+			t.Errorf("IntToRoman(%d) = %q, %v, want %q", c.n, got, err, c.want)
+		}
+	}
+
+	for _, n := range []int{0, -1, 4000} {
+		if got, err := IntToRoman(n); err != ErrOutOfRange {
+			t.Errorf("IntToRoman(%d) = %q, %v, want ErrOutOfRange", n, got, err)
+		}
+	}
+}
+
+func TestRomanToIntInvalid(t *testing.T) {
+	for _, s := range []string{"", "IIII", "VV", "IC", "IL", "XM", "VX", "MMMM", "iv", "XIIX", "A", "IXI"} {
+		if got, err := RomanToInt(s); err != ErrInvalidRoman {
+			t.Errorf("RomanToInt(%q) = %d, %v, want ErrInvalidRoman", s, got, err)
+		}
+	}
+}
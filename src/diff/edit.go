@@ -0,0 +1,50 @@
+package diff
+
+import "errors"
+
+type Op int
+
+const (
+	Keep Op = iota
+	Insert
+	Delete
+)
+
+// Edit is one step of an edit script. AIndex is the element's position in the
+// original sequence and BIndex its position in the target one; the side an
+// element is missing from is -1.
+type Edit[T any] struct {
+	Op     Op
+	Value  T
+	AIndex int
+	BIndex int
+}
+
+var ErrScriptMismatch = errors.New("diff: edit script does not match input")
+
+// Apply walks script over a and returns the sequence it produces.
+func Apply[T comparable](a []T, script []Edit[T]) ([]T, error) {
+	var out []T
+	i := 0
+
+	for _, e := range script {
+		switch e.Op {
+		case Keep, Delete:
+			if i >= len(a) || a[i] != e.Value {
+				return nil, ErrScriptMismatch
+			}
+			if e.Op == Keep {
+				out = append(out, e.Value)
+			}
+			i++
+		case Insert:
+			out = append(out, e.Value)
+		}
+	}
+
+	if i != len(a) {
+		return nil, ErrScriptMismatch
+	}
+
+	return out, nil
+}
@@ -0,0 +1,17 @@
+package diff
+
+import "testing"
+
+func TestApplyMismatch(t *testing.T) {
+	a := []string{"x", "y"}
+	scripts := [][]Edit[string]{
+		{{Op: Keep, Value: "x"}},
+		{{Op: Keep, Value: "x"}, {Op: Delete, Value: "z"}},
+		{{Op: Keep, Value: "x"}, {Op: Keep, Value: "y"}, {Op: Keep, Value: "y"}},
+	}
+	for _, script := range scripts {
+		if _, err := Apply(a, script); err != ErrScriptMismatch {
+			t.Errorf("Apply(%v) error = %v, want ErrScriptMismatch", script, err)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package diff
+
+// Conflict is a region both sides changed differently. Its contents are left
+// out of the merged output; Offset is the index in merged where the region
+// belongs, so callers can splice in markers or a resolution.
+type Conflict[T any] struct {
+	Offset int
+	Base   []T
+	A      []T
+	B      []T
+}
+
+func matches[T comparable](base, other []T) []int {
+	match := make([]int, len(base))
+	for i := range match {
+		match[i] = -1
+	}
+
+	for _, e := range Myers(base, other) {
+		if e.Op == Keep {
+			match[e.AIndex] = e.BIndex
+		}
+	}
+
+	return match
+}
+
+func equal[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Merge3 merges a and b, two revisions of base. Runs of base kept by both
+// sides anchor the merge; between anchors a region changed on only one side,
+// or changed identically on both, is taken as is, and anything else is
+// reported as a conflict.
+func Merge3[T comparable](base, a, b []T) (merged []T, conflicts []Conflict[T]) {
+	matchA := matches(base, a)
+	matchB := matches(base, b)
+
+	i, ja, jb := 0, 0, 0
+	for i < len(base) || ja < len(a) || jb < len(b) {
+		k := 0
+		for i+k < len(base) && matchA[i+k] == ja+k && matchB[i+k] == jb+k {
+			k++
+		}
+		if k > 0 {
+			merged = append(merged, base[i:i+k]...)
+			i, ja, jb = i+k, ja+k, jb+k
+			continue
+		}
+
+		next, endA, endB := i, len(a), len(b)
+		for next < len(base) && (matchA[next] == -1 || matchB[next] == -1) {
+			next++
+		}
+		if next < len(base) {
+			endA, endB = matchA[next], matchB[next]
+		}
+
+		baseRegion, aRegion, bRegion := base[i:next], a[ja:endA], b[jb:endB]
+		switch {
+		case equal(aRegion, baseRegion):
+			merged = append(merged, bRegion...)
+		case equal(bRegion, baseRegion), equal(aRegion, bRegion):
+			merged = append(merged, aRegion...)
+		default:
+			conflicts = append(conflicts, Conflict[T]{
+				Offset: len(merged),
+				Base:   baseRegion,
+				A:      aRegion,
+				B:      bRegion,
+			})
+		}
+
+		i, ja, jb = next, endA, endB
+	}
+
+	return merged, conflicts
+}
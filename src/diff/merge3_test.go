@@ -0,0 +1,71 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMerge3Clean(t *testing.T) {
+	base := lines("a\nb\nc\nd\ne")
+	a := lines("A\nb\nc\nd\ne")
+	b := lines("a\nb\nc\nd\nE\nf")
+
+	merged, conflicts := Merge3(base, a, b)
+	if len(conflicts) != 0 {
+		t.Fatalf("conflicts = %v, want none", conflicts)
+	}
+	if want := lines("A\nb\nc\nd\nE\nf"); !reflect.DeepEqual(merged, want) {
+		t.Errorf("merged = %q, want %q", merged, want)
+	}
+}
+
+func TestMerge3InsertAndDelete(t *testing.T) {
+	base := lines("a\nb\nc\nd")
+	a := lines("a\nc\nd")
+	b := lines("a\nb\nc\nx\nd")
+
+	merged, conflicts := Merge3(base, a, b)
+	if len(conflicts) != 0 {
+		t.Fatalf("conflicts = %v, want none", conflicts)
+	}
+	if want := lines("a\nc\nx\nd"); !reflect.DeepEqual(merged, want) {
+		t.Errorf("merged = %q, want %q", merged, want)
+	}
+}
+
+func TestMerge3Conflict(t *testing.T) {
+	base := lines("a\nb\nc")
+	a := lines("a\nB1\nc")
+	b := lines("a\nB2\nc")
+
+	merged, conflicts := Merge3(base, a, b)
+	if want := lines("a\nc"); !reflect.DeepEqual(merged, want) {
+		t.Errorf("merged = %q, want %q", merged, want)
+	}
+
+	want := []Conflict[string]{{Offset: 1, Base: []string{"b"}, A: []string{"B1"}, B: []string{"B2"}}}
+	if !reflect.DeepEqual(conflicts, want) {
+		t.Errorf("conflicts = %+v, want %+v", conflicts, want)
+	}
+}
+
+func TestMerge3IdenticalChanges(t *testing.T) {
+	base := lines("a\nb\nc")
+	both := lines("a\nnew\nc\nd")
+
+	merged, conflicts := Merge3(base, both, both)
+	if len(conflicts) != 0 {
+		t.Fatalf("conflicts = %v, want none", conflicts)
+	}
+	if !reflect.DeepEqual(merged, both) {
+		t.Errorf("merged = %q, want %q", merged, both)
+	}
+}
+
+func TestMerge3Unchanged(t *testing.T) {
+	base := lines("a\nb")
+	merged, conflicts := Merge3(base, base, base)
+	if len(conflicts) != 0 || !reflect.DeepEqual(merged, base) {
+		t.Errorf("Merge3(base, base, base) = %q, %v", merged, conflicts)
+	}
+}
@@ -0,0 +1,84 @@
+package diff
+
+// Myers computes a shortest edit script from a to b with Myers' O(ND)
+// algorithm: it explores furthest-reaching paths for each edit distance D and
+// then walks the recorded frontiers back from the end.
+func Myers[T comparable](a, b []T) []Edit[T] {
+	n, m := len(a), len(b)
+	limit := n + m
+	offset := limit + 1
+
+	v := make([]int, 2*limit+3)
+	var trace [][]int
+
+	d := 0
+search:
+	for d <= limit {
+		trace = append(trace, append([]int{}, v...))
+
+		k := -d
+		for k <= d {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				break search
+			}
+			k += 2
+		}
+		d++
+	}
+
+	var reversed []Edit[T]
+	x, y := n, m
+
+	for d >= 0 {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			reversed = append(reversed, Edit[T]{Op: Keep, Value: a[x], AIndex: x, BIndex: y})
+		}
+
+		if d > 0 {
+			if x == prevX {
+				y--
+				reversed = append(reversed, Edit[T]{Op: Insert, Value: b[y], AIndex: -1, BIndex: y})
+			} else {
+				x--
+				reversed = append(reversed, Edit[T]{Op: Delete, Value: a[x], AIndex: x, BIndex: -1})
+			}
+		}
+
+		d--
+	}
+
+	script := make([]Edit[T], len(reversed))
+	for i, e := range reversed {
+		script[len(reversed)-1-i] = e
+	}
+
+	return script
+}
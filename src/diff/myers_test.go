@@ -0,0 +1,161 @@
+package diff
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func lines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+func lcsLength(a, b []string) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+
+	i := len(a) - 1
+	for i >= 0 {
+		j := len(b) - 1
+		for j >= 0 {
+			if a[i] == b[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else if dp[i+1][j] > dp[i][j+1] {
+				dp[i][j] = dp[i+1][j]
+			} else {
+				dp[i][j] = dp[i][j+1]
+			}
+			j--
+		}
+		i--
+	}
+	return dp[0][0]
+}
+
+func editCount[T any](script []Edit[T]) int {
+	count := 0
+	for _, e := range script {
+		if e.Op != Keep {
+			count++
+		}
+	}
+	return count
+}
+
+// checkScript applies script to a, expects b, and checks that the recorded
+// indexes walk both sequences in order.
+func checkScript(t *testing.T, a, b []string, script []Edit[string]) {
+	t.Helper()
+
+	got, err := Apply(a, script)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if len(got) != len(b) || (len(b) > 0 && !reflect.DeepEqual(got, b)) {
+		t.Fatalf("Apply = %q, want %q", got, b)
+	}
+
+	i, j := 0, 0
+	for _, e := range script {
+		switch e.Op {
+		case Keep:
+			if e.AIndex != i || e.BIndex != j {
+				t.Fatalf("keep %v: indexes (%d, %d), want (%d, %d)", e.Value, e.AIndex, e.BIndex, i, j)
+			}
+			i++
+			j++
+		case Delete:
+			if e.AIndex != i || e.BIndex != -1 {
+				t.Fatalf("delete %v: indexes (%d, %d), want (%d, -1)", e.Value, e.AIndex, e.BIndex, i)
+			}
+			i++
+		case Insert:
+			if e.AIndex != -1 || e.BIndex != j {
+				t.Fatalf("insert %v: indexes (%d, %d), want (-1, %d)", e.Value, e.AIndex, e.BIndex, j)
+			}
+			j++
+		}
+	}
+}
+
+func TestMyersKnown(t *testing.T) {
+	cases := []struct {
+		a, b  string
+		edits int
+	}{
+		{"a\nb\nc\na\nb\nb\na", "c\nb\na\nb\na\nc", 5},
+		{"one\ntwo\nthree", "one\n2\nthree", 2},
+		{"x\ny", "x\ny\nz", 1},
+		{"x\ny\nz", "y\nz", 1},
+	}
+	for _, c := range cases {
+		a, b := lines(c.a), lines(c.b)
+		script := Myers(a, b)
+		checkScript(t, a, b, script)
+		if got := editCount(script); got != c.edits {
+			t.Errorf("Myers(%q, %q) has %d edits, want %d", c.a, c.b, got, c.edits)
+		}
+	}
+}
+
+func TestMyersMinimal(t *testing.T) {
+	r := rand.New(rand.NewSource(239))
+	alphabet := []string{"a", "b", "c", "d"}
+
+	trial := 0
+	for trial < 300 {
+		a := make([]string, r.Intn(15))
+		b := make([]string, r.Intn(15))
+		for i := range a {
+			a[i] = alphabet[r.Intn(len(alphabet))]
+		}
+		for i := range b {
+			b[i] = alphabet[r.Intn(len(alphabet))]
+		}
+
+		script := Myers(a, b)
+		checkScript(t, a, b, script)
+		if got, want := editCount(script), len(a)+len(b)-2*lcsLength(a, b); got != want {
+			t.Fatalf("Myers(%q, %q) has %d edits, minimum is %d", a, b, got, want)
+		}
+		trial++
+	}
+}
+
+func TestMyersIdentical(t *testing.T) {
+	a := lines("same\nlines\nhere")
+	script := Myers(a, a)
+	if len(script) != len(a) || editCount(script) != 0 {
+		t.Errorf("Myers of identical input = %v, want only keeps", script)
+	}
+}
+
+func TestMyersEmpty(t *testing.T) {
+	b := lines("p\nq")
+
+	script := Myers(nil, b)
+	checkScript(t, nil, b, script)
+	for _, e := range script {
+		if e.Op != Insert {
+			t.Errorf("Myers(nil, b) contains %v, want only inserts", e)
+		}
+	}
+
+	script = Myers(b, nil)
+	checkScript(t, b, nil, script)
+	for _, e := range script {
+		if e.Op != Delete {
+			t.Errorf("Myers(a, nil) contains %v, want only deletes", e)
+		}
+	}
+
+	if script := Myers[string](nil, nil); len(script) != 0 {
+		t.Errorf("Myers(nil, nil) = %v, want empty", script)
+	}
+}
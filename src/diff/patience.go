@@ -0,0 +1,132 @@
+package diff
+
+import "sort"
+
+// Patience diffs a and b by anchoring on elements that occur exactly once in
+// each, keeping the longest run of such anchors that appear in the same order
+// on both sides, and recursing between them. Regions with no unique elements
+// fall back to Myers. The result is often easier to read than a Myers diff
+// because common blocks like closing braces don't get matched across
+// unrelated changes.
+func Patience[T comparable](a, b []T) []Edit[T] {
+	return patience(a, b, 0, len(a), 0, len(b), nil)
+}
+
+func patience[T comparable](a, b []T, aLo, aHi, bLo, bHi int, script []Edit[T]) []Edit[T] {
+	for aLo < aHi && bLo < bHi && a[aLo] == b[bLo] {
+		script = append(script, Edit[T]{Op: Keep, Value: a[aLo], AIndex: aLo, BIndex: bLo})
+		aLo++
+		bLo++
+	}
+
+	var suffix []Edit[T]
+	for aLo < aHi && bLo < bHi && a[aHi-1] == b[bHi-1] {
+		aHi--
+		bHi--
+		suffix = append(suffix, Edit[T]{Op: Keep, Value: a[aHi], AIndex: aHi, BIndex: bHi})
+	}
+
+	anchors := uniqueAnchors(a, b, aLo, aHi, bLo, bHi)
+	if len(anchors) == 0 {
+		for _, e := range Myers(a[aLo:aHi], b[bLo:bHi]) {
+			if e.AIndex >= 0 {
+				e.AIndex += aLo
+			}
+			if e.BIndex >= 0 {
+				e.BIndex += bLo
+			}
+			script = append(script, e)
+		}
+	} else {
+		for _, anchor := range anchors {
+			script = patience(a, b, aLo, anchor[0], bLo, anchor[1], script)
+			script = append(script, Edit[T]{Op: Keep, Value: a[anchor[0]], AIndex: anchor[0], BIndex: anchor[1]})
+			aLo, bLo = anchor[0]+1, anchor[1]+1
+		}
+		script = patience(a, b, aLo, aHi, bLo, bHi, script)
+	}
+
+	i := len(suffix) - 1
+	for i >= 0 {
+		script = append(script, suffix[i])
+		i--
+	}
+
+	return script
+}
+
+// uniqueAnchors pairs up the elements occurring exactly once in both ranges
+// and returns the longest subsequence of pairs increasing on both sides,
+// found by patience sorting.
+func uniqueAnchors[T comparable](a, b []T, aLo, aHi, bLo, bHi int) [][2]int {
+	type occurrence struct {
+		countA, countB int
+		indexA, indexB int
+	}
+
+	seen := map[T]*occurrence{}
+	i := aLo
+	for i < aHi {
+		o, ok := seen[a[i]]
+		if !ok {
+			o = &occurrence{}
+			seen[a[i]] = o
+		}
+		o.countA++
+		o.indexA = i
+		i++
+	}
+
+	j := bLo
+	for j < bHi {
+		if o, ok := seen[b[j]]; ok {
+			o.countB++
+			o.indexB = j
+		}
+		j++
+	}
+
+	var pairs [][2]int
+	i = aLo
+	for i < aHi {
+		if o := seen[a[i]]; o.countA == 1 && o.countB == 1 {
+			pairs = append(pairs, [2]int{o.indexA, o.indexB})
+		}
+		i++
+	}
+
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	var tops []int
+	previous := make([]int, len(pairs))
+	for k, p := range pairs {
+		pile := sort.Search(len(tops), func(t int) bool {
+			return pairs[tops[t]][1] > p[1]
+		})
+
+		if pile > 0 {
+			previous[k] = tops[pile-1]
+		} else {
+			previous[k] = -1
+		}
+
+		if pile == len(tops) {
+			tops = append(tops, k)
+		} else {
+			tops[pile] = k
+		}
+	}
+
+	lis := make([][2]int, len(tops))
+	k := tops[len(tops)-1]
+	i = len(tops) - 1
+	for i >= 0 {
+		lis[i] = pairs[k]
+		k = previous[k]
+		i--
+	}
+
+	return lis
+}
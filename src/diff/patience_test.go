@@ -0,0 +1,78 @@
+package diff
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func hunks[T any](script []Edit[T]) int {
+	count := 0
+	prev := Keep
+	for _, e := range script {
+		if e.Op != Keep && prev == Keep {
+			count++
+		}
+		prev = e.Op
+	}
+	return count
+}
+
+func TestPatienceAlignsBlocks(t *testing.T) {
+	// Replacing k() with h() and g() ahead of an untouched f(). Myers pairs
+	// k()'s closing brace with h()'s and splits the change in two; patience
+	// anchors on the unique lines and reports one contiguous change.
+	a := lines("k() {\n  k\n}\nf() {\n  f\n}")
+	b := lines("h() {\n  h\n}\ng() {\n  g\n}\nf() {\n  f\n}")
+
+	script := Patience(a, b)
+	checkScript(t, a, b, script)
+
+	if got := hunks(script); got != 1 {
+		t.Errorf("Patience produced %d hunks, want 1", got)
+	}
+	if got := hunks(Myers(a, b)); got <= 1 {
+		t.Errorf("Myers produced %d hunks; the example no longer shows the difference", got)
+	}
+
+	kept := map[string]bool{}
+	for _, e := range script {
+		if e.Op == Keep {
+			kept[e.Value] = true
+		}
+	}
+	for _, line := range []string{"f() {", "  f", "}"} {
+		if !kept[line] {
+			t.Errorf("Patience did not keep %q from the untouched block", line)
+		}
+	}
+}
+
+func TestPatienceReconstructs(t *testing.T) {
+	r := rand.New(rand.NewSource(241))
+	alphabet := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+
+	trial := 0
+	for trial < 300 {
+		a := make([]string, r.Intn(20))
+		b := make([]string, r.Intn(20))
+		for i := range a {
+			a[i] = alphabet[r.Intn(len(alphabet))]
+		}
+		for i := range b {
+			b[i] = alphabet[r.Intn(len(alphabet))]
+		}
+
+		checkScript(t, a, b, Patience(a, b))
+		trial++
+	}
+}
+
+func TestPatienceEdgeCases(t *testing.T) {
+	same := lines("x\ny\nx")
+	if script := Patience(same, same); editCount(script) != 0 || len(script) != len(same) {
+		t.Errorf("Patience of identical input = %v, want only keeps", script)
+	}
+
+	checkScript(t, nil, same, Patience(nil, same))
+	checkScript(t, same, nil, Patience(same, nil))
+}
@@ -0,0 +1,23 @@
+package dp
+
+import "cache"
+
+// BoundedMemoize caches fn's results in an LRU holding at most capacity keys,
+// so evicted results are simply recomputed on their next use. For recursion,
+// fn should call the returned function rather than itself. The returned
+// function is not safe for concurrent use.
+func BoundedMemoize[K comparable, V any](capacity int, fn func(K) V) func(K) V {
+	lru := cache.NewWeightedLRU[K, V](capacity, func(K, V) int {
+		return 1
+	})
+
+	return func(key K) V {
+		if value, ok := lru.Get(key); ok {
+			return value
+		}
+
+		value := fn(key)
+		lru.Put(key, value)
+		return value
+	}
+}
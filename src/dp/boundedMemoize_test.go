@@ -0,0 +1,72 @@
+package dp
+
+import "testing"
+
+func TestBoundedMemoizeFibonacci(t *testing.T) {
+	calls := 0
+	var fib func(int) int
+	fib = BoundedMemoize(8, func(n int) int {
+		calls++
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+
+	if got := fib(60); got != 1548008755920 {
+		t.Errorf("fib(60) = %d, want 1548008755920", got)
+	}
+	if calls != 61 {
+		t.Errorf("fn called %d times, want 61", calls)
+	}
+
+	// Recent results are still cached.
+	before := calls
+	fib(60)
+	if calls != before {
+		t.Errorf("cached call ran fn %d more times", calls-before)
+	}
+}
+
+func TestBoundedMemoizeEviction(t *testing.T) {
+	const capacity = 3
+	calls := map[int]int{}
+	square := BoundedMemoize(capacity, func(n int) int {
+		calls[n]++
+		return n * n
+	})
+
+	for _, n := range []int{1, 2, 3, 1, 4} {
+		if got := square(n); got != n*n {
+			t.Fatalf("square(%d) = %d", n, got)
+		}
+	}
+	// 1 was touched before 4 arrived, so 2 was evicted instead.
+	if calls[1] != 1 {
+		t.Errorf("1 computed %d times, want 1", calls[1])
+	}
+	square(2)
+	if calls[2] != 2 {
+		t.Errorf("evicted 2 computed %d times, want 2", calls[2])
+	}
+
+	// Cycling through more keys than fit recomputes each one every time, but
+	// the answers stay correct.
+	calls = map[int]int{}
+	round := 0
+	for round < 3 {
+		n := 10
+		for n < 10+capacity+1 {
+			if got := square(n); got != n*n {
+				t.Fatalf("square(%d) = %d", n, got)
+			}
+			n++
+		}
+		round++
+	}
+	for n, c := range calls {
+		if c != 3 {
+			t.Errorf("%d computed %d times over 3 rounds, want 3", n, c)
+		}
+	}
+}
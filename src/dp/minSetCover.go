@@ -0,0 +1,59 @@
+package dp
+
+import "errors"
+
+const MAX_UNIVERSE_BITS = 20
+
+var (
+	ErrUniverseTooLarge = errors.New("dp: universe exceeds MAX_UNIVERSE_BITS elements")
+	ErrUncoverable      = errors.New("dp: sets do not cover the universe")
+)
+
+// MinSetCover finds the fewest sets whose union covers universe, where both
+// are bitmasks over at most MAX_UNIVERSE_BITS elements. It runs a BFS over
+// covered-element states, so the first time the full universe is reached uses
+// the minimum number of sets. The chosen sets are returned as indices into
+// sets.
+func MinSetCover(universe int, sets []int) (int, []int, error) {
+	if universe < 0 || universe >= 1<<MAX_UNIVERSE_BITS {
+		return 0, nil, ErrUniverseTooLarge
+	}
+
+	states := universe + 1
+	parentState := make([]int, states)
+	parentSet := make([]int, states)
+	i := 0
+	for i < states {
+		parentState[i] = -1
+		i++
+	}
+
+	parentState[0] = 0
+	queue := []int{0}
+	for len(queue) > 0 && parentState[universe] == -1 {
+		mask := queue[0]
+		queue = queue[1:]
+
+		for k, s := range sets {
+			next := mask | (s & universe)
+			if parentState[next] == -1 {
+				parentState[next] = mask
+				parentSet[next] = k
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	if parentState[universe] == -1 {
+		return 0, nil, ErrUncoverable
+	}
+
+	var chosen []int
+	mask := universe
+	for mask != 0 {
+		chosen = append(chosen, parentSet[mask])
+		mask = parentState[mask]
+	}
+
+	return len(chosen), chosen, nil
+}
@@ -0,0 +1,98 @@
+package dp
+
+import (
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+func bruteSetCover(universe int, sets []int) int {
+	best := -1
+	choice := 0
+	for choice < 1<<len(sets) {
+		covered := 0
+		for k, s := range sets {
+			if choice&(1<<k) != 0 {
+				covered |= s
+			}
+		}
+		if covered&universe == universe {
+			if count := bits.OnesCount(uint(choice)); best == -1 || count < best {
+				best = count
+			}
+		}
+		choice++
+	}
+	return best
+}
+
+func checkCover(t *testing.T, universe int, sets []int, count int, chosen []int) {
+	t.Helper()
+
+	if len(chosen) != count {
+		t.Fatalf("count %d but %d sets chosen", count, len(chosen))
+	}
+	covered := 0
+	for _, k := range chosen {
+		covered |= sets[k]
+	}
+	if covered&universe != universe {
+		t.Errorf("chosen sets %v cover %b, want %b", chosen, covered, universe)
+	}
+}
+
+func TestMinSetCoverKnown(t *testing.T) {
+	// Elements 0..5: no single set covers them, but 0b000111 and 0b111000
+	// together do.
+	universe := 0b111111
+	sets := []int{0b000011, 0b001110, 0b111000, 0b000111, 0b110000}
+
+	count, chosen, err := MinSetCover(universe, sets)
+	if err != nil {
+		t.Fatalf("MinSetCover: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("count = %d, want 2", count)
+	}
+	checkCover(t, universe, sets, count, chosen)
+}
+
+func TestMinSetCoverRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(247))
+
+	trial := 0
+	for trial < 200 {
+		bitsUsed := 1 + r.Intn(8)
+		universe := 1<<bitsUsed - 1
+		sets := make([]int, r.Intn(8))
+		for i := range sets {
+			sets[i] = r.Intn(1 << (bitsUsed + 1))
+		}
+
+		want := bruteSetCover(universe, sets)
+		count, chosen, err := MinSetCover(universe, sets)
+		if want == -1 {
+			if err != ErrUncoverable {
+				t.Fatalf("sets %b: err = %v, want ErrUncoverable", sets, err)
+			}
+		} else {
+			if err != nil || count != want {
+				t.Fatalf("sets %b: MinSetCover = %d, %v, want %d", sets, count, err, want)
+			}
+			checkCover(t, universe, sets, count, chosen)
+		}
+		trial++
+	}
+}
+
+func TestMinSetCoverErrors(t *testing.T) {
+	if _, _, err := MinSetCover(0b111, []int{0b011}); err != ErrUncoverable {
+		t.Errorf("uncoverable universe: err = %v, want ErrUncoverable", err)
+	}
+	if _, _, err := MinSetCover(1<<MAX_UNIVERSE_BITS, nil); err != ErrUniverseTooLarge {
+		t.Errorf("oversized universe: err = %v, want ErrUniverseTooLarge", err)
+	}
+	if count, chosen, err := MinSetCover(0, nil); err != nil || count != 0 || len(chosen) != 0 {
+		t.Errorf("empty universe = %d, %v, %v, want 0 sets", count, chosen, err)
+	}
+}
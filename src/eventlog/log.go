@@ -0,0 +1,57 @@
+package eventlog
+
+import (
+	"errors"
+	"sync"
+)
+
+var ErrOffsetOutOfRange = errors.New("eventlog: offset out of range")
+
+// Log is an append-only sequence of events. State is never stored here;
+// callers rebuild it by replaying events, possibly from a count returned by
+// Snapshot on top of state they saved at that point. It is safe for
+// concurrent use.
+type Log[E any] struct {
+	mu     sync.RWMutex
+	events []E
+}
+
+func NewLog[E any]() *Log[E] {
+	return &Log[E]{}
+}
+
+// Append adds event and returns its offset.
+func (l *Log[E]) Append(event E) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.events = append(l.events, event)
+	return len(l.events) - 1
+}
+
+// Snapshot returns the number of events appended so far, which is also the
+// offset the next event will get.
+func (l *Log[E]) Snapshot() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return len(l.events)
+}
+
+// Replay calls fn on every event from offset from onwards, oldest first.
+// Events appended while it runs are not included. from may equal Snapshot(),
+// replaying nothing; anything outside [0, Snapshot()] is an error.
+func (l *Log[E]) Replay(from int, fn func(E)) error {
+	l.mu.RLock()
+	events := l.events
+	l.mu.RUnlock()
+
+	if from < 0 || from > len(events) {
+		return ErrOffsetOutOfRange
+	}
+
+	for _, e := range events[from:] {
+		fn(e)
+	}
+	return nil
+}
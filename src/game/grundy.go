@@ -0,0 +1,46 @@
+package game
+
+// Mex returns the minimum excludant of values: the smallest non-negative
+// integer not among them.
+func Mex(values []int) int {
+	present := make([]bool, len(values)+1)
+	for _, v := range values {
+		if v >= 0 && v < len(present) {
+			present[v] = true
+		}
+	}
+
+	m := 0
+	for present[m] {
+		m++
+	}
+	return m
+}
+
+// GrundyNumber returns the Grundy value of state in an impartial game: the
+// mex of the Grundy values of the states reachable in one move, so a state
+// with no moves has value 0. A position is lost for the player to move exactly
+// when its value is 0, and a sum of independent games has the XOR of their
+// values. The game must be finite and acyclic; each reachable state is
+// evaluated once.
+func GrundyNumber[State comparable](state State, moves func(State) []State) int {
+	memo := map[State]int{}
+
+	var grundy func(s State) int
+	grundy = func(s State) int {
+		if value, ok := memo[s]; ok {
+			return value
+		}
+
+		var reachable []int
+		for _, next := range moves(s) {
+			reachable = append(reachable, grundy(next))
+		}
+
+		value := Mex(reachable)
+		memo[s] = value
+		return value
+	}
+
+	return grundy(state)
+}
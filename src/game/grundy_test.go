@@ -0,0 +1,101 @@
+package game
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var subtractions = []int{1, 3, 4}
+
+// subtractionMoves takes 1, 3 or 4 stones from a single pile.
+func subtractionMoves(stones int) []int {
+	var next []int
+	for _, take := range subtractions {
+		if take <= stones {
+			next = append(next, stones-take)
+		}
+	}
+	return next
+}
+
+// sumMoves plays subtractionMoves on any one of three piles.
+func sumMoves(piles [3]int) [][3]int {
+	var next [][3]int
+	for i, stones := range piles {
+		for _, rest := range subtractionMoves(stones) {
+			moved := piles
+			moved[i] = rest
+			next = append(next, moved)
+		}
+	}
+	return next
+}
+
+// wins reports by exhaustive search whether the player to move can force a
+// win, taking the last stone overall.
+func wins(piles [3]int, memo map[[3]int]bool) bool {
+	if w, ok := memo[piles]; ok {
+		return w
+	}
+	w := false
+	for _, next := range sumMoves(piles) {
+		if !wins(next, memo) {
+			w = true
+			break
+		}
+	}
+	memo[piles] = w
+	return w
+}
+
+func TestMex(t *testing.T) {
+	cases := []struct {
+		values []int
+		want   int
+	}{
+		{nil, 0},
+		{[]int{1, 2}, 0},
+		{[]int{0, 1, 2}, 3},
+		{[]int{2, 0, 0, 3}, 1},
+		{[]int{-1, 5, 0}, 1},
+	}
+	for _, c := range cases {
+		if got := Mex(c.values); got != c.want {
+			t.Errorf("Mex(%v) = %d, want %d", c.values, got, c.want)
+		}
+	}
+}
+
+func TestGrundySubtractionGame(t *testing.T) {
+	// The values for moves {1, 3, 4} repeat with period 7.
+	period := []int{0, 1, 0, 1, 2, 3, 2}
+	stones := 0
+	for stones < 30 {
+		if got, want := GrundyNumber(stones, subtractionMoves), period[stones%7]; got != want {
+			t.Errorf("GrundyNumber(%d) = %d, want %d", stones, got, want)
+		}
+		stones++
+	}
+}
+
+func TestGrundyPredictsGameSums(t *testing.T) {
+	r := rand.New(rand.NewSource(278))
+	memo := map[[3]int]bool{}
+
+	trial := 0
+	for trial < 200 {
+		piles := [3]int{r.Intn(15), r.Intn(15), r.Intn(15)}
+
+		xor := 0
+		for _, stones := range piles {
+			xor ^= GrundyNumber(stones, subtractionMoves)
+		}
+		if got, want := xor != 0, wins(piles, memo); got != want {
+			t.Fatalf("XOR of Grundy values for %v predicts win %v, search says %v", piles, got, want)
+		}
+		if whole := GrundyNumber(piles, sumMoves); whole != xor {
+			t.Fatalf("GrundyNumber(%v) = %d, want the XOR %d of its components", piles, whole, xor)
+		}
+		trial++
+	}
+}
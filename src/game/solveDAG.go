@@ -0,0 +1,47 @@
+package game
+
+type position[State comparable] struct {
+	state      State
+	maximizing bool
+}
+
+// SolveDAG returns the minimax value of start, where the player to move at
+// start maximizes terminalValue and the players alternate. A state with no
+// moves is scored like a terminal one. The transition graph must be acyclic;
+// each (state, player to move) pair is evaluated once, so the cost is linear
+// in the number of reachable positions rather than the number of move
+// sequences.
+func SolveDAG[State comparable](start State, moves func(State) []State, isTerminal func(State) bool, terminalValue func(State) int) int {
+	memo := map[position[State]]int{}
+
+	var solve func(p position[State]) int
+	solve = func(p position[State]) int {
+		if value, ok := memo[p]; ok {
+			return value
+		}
+
+		var next []State
+		if !isTerminal(p.state) {
+			next = moves(p.state)
+		}
+
+		var value int
+		if len(next) == 0 {
+			value = terminalValue(p.state)
+		} else {
+			i := 0
+			for i < len(next) {
+				v := solve(position[State]{state: next[i], maximizing: !p.maximizing})
+				if i == 0 || (p.maximizing && v > value) || (!p.maximizing && v < value) {
+					value = v
+				}
+				i++
+			}
+		}
+
+		memo[p] = value
+		return value
+	}
+
+	return solve(position[State]{state: start, maximizing: true})
+}
@@ -0,0 +1,94 @@
+package game
+
+import "testing"
+
+// nimState is a single pile from which a move takes one or two stones. The
+// player who takes the last stone wins, scored +1 for the maximizer.
+type nimState struct {
+	stones    int
+	maxToMove bool
+}
+
+func nimMoves(calls *int) func(nimState) []nimState {
+	return func(s nimState) []nimState {
+		*calls++
+		var next []nimState
+		for _, take := range []int{1, 2} {
+			if take <= s.stones {
+				next = append(next, nimState{stones: s.stones - take, maxToMove: !s.maxToMove})
+			}
+		}
+		return next
+	}
+}
+
+func nimTerminal(s nimState) bool {
+	return s.stones == 0
+}
+
+// nimValue scores a finished game: whoever is to move has no stones left and
+// so lost.
+func nimValue(s nimState) int {
+	if s.maxToMove {
+		return -1
+	}
+	return 1
+}
+
+func TestSolveDAGNim(t *testing.T) {
+	// With moves of one or two, the player to move loses exactly when the
+	// pile is a multiple of three.
+	stones := 0
+	for stones < 20 {
+		calls := 0
+		got := SolveDAG(nimState{stones: stones, maxToMove: true}, nimMoves(&calls), nimTerminal, nimValue)
+
+		want := 1
+		if stones%3 == 0 {
+			want = -1
+		}
+		if got != want {
+			t.Errorf("SolveDAG(pile of %d) = %d, want %d", stones, got, want)
+		}
+		stones++
+	}
+}
+
+func TestSolveDAGMemoizes(t *testing.T) {
+	// Unmemoized, a pile of 60 would take Fibonacci(60) move expansions.
+	// Memoized, each pile size is expanded at most once per player to move.
+	const stones = 60
+	calls := 0
+	SolveDAG(nimState{stones: stones, maxToMove: true}, nimMoves(&calls), nimTerminal, nimValue)
+
+	if calls > 2*stones {
+		t.Errorf("moves called %d times for %d non-terminal positions", calls, 2*stones)
+	}
+}
+
+func TestSolveDAGScores(t *testing.T) {
+	// A two-level tree: the maximizer picks the branch whose worst leaf, as
+	// chosen by the minimizer, is best.
+	tree := map[string][]string{
+		"root": {"a", "b"},
+		"a":    {"a1", "a2"},
+		"b":    {"b1", "b2"},
+	}
+	leaves := map[string]int{"a1": 3, "a2": 12, "b1": 8, "b2": 5}
+
+	got := SolveDAG("root",
+		func(s string) []string { return tree[s] },
+		func(s string) bool { _, ok := leaves[s]; return ok },
+		func(s string) int { return leaves[s] })
+	if got != 5 {
+		t.Errorf("SolveDAG(tree) = %d, want 5", got)
+	}
+
+	only := SolveDAG("x",
+		func(string) []string { return nil },
+		func(string) bool { return false },
+		func(string) int { return 7 })
+	if only != 7 {
+		t.Errorf("SolveDAG(state with no moves) = %d, want its terminal value 7", only)
+	}
+}
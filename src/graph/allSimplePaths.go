@@ -0,0 +1,37 @@
+package graph
+
+// AllSimplePaths enumerates every path from src to dst that repeats no vertex.
+// The number of such paths grows exponentially, so this is only meant for
+// small graphs. When src == dst the single trivial path [src] is returned.
+func AllSimplePaths(g *Graph, src, dst Vertex) [][]Vertex {
+	if !g.HasVertex(src) || !g.HasVertex(dst) {
+		return nil
+	}
+
+	var paths [][]Vertex
+	path := []Vertex{src}
+	onPath := map[Vertex]bool{src: true}
+
+	var explore func(v Vertex)
+	explore = func(v Vertex) {
+		if v == dst {
+			paths = append(paths, append([]Vertex{}, path...))
+			return
+		}
+
+		for _, e := range g.adjacency[v] {
+			if onPath[e.To] {
+				continue
+			}
+
+			onPath[e.To] = true
+			path = append(path, e.To)
+			explore(e.To)
+			path = path[:len(path)-1]
+			onPath[e.To] = false
+		}
+	}
+
+	explore(src)
+	return paths
+}
@@ -0,0 +1,65 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+func pathKeys(paths [][]Vertex) []string {
+	keys := make([]string, len(paths))
+	for i, p := range paths {
+		keys[i] = fmt.Sprint(p)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestAllSimplePaths(t *testing.T) {
+	// 1 → 2 → 4, 1 → 3 → 4, 2 ⇄ 3 and a back edge 4 → 1 that no simple path
+	// from 1 may use.
+	g := NewGraph(true)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(2, 4, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 2, 1)
+	g.AddEdge(4, 1, 1)
+
+	got := pathKeys(AllSimplePaths(g, 1, 4))
+	want := pathKeys([][]Vertex{{1, 2, 4}, {1, 3, 4}, {1, 2, 3, 4}, {1, 3, 2, 4}})
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("AllSimplePaths(1, 4) = %v, want %v", got, want)
+	}
+}
+
+func TestAllSimplePathsUndirected(t *testing.T) {
+	g := NewGraph(false)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(1, 3, 1)
+
+	got := pathKeys(AllSimplePaths(g, 1, 3))
+	want := pathKeys([][]Vertex{{1, 3}, {1, 2, 3}})
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("AllSimplePaths(1, 3) = %v, want %v", got, want)
+	}
+}
+
+func TestAllSimplePathsTrivialAndMissing(t *testing.T) {
+	g := NewGraph(true)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 1, 1)
+	g.AddVertex(3)
+
+	if got := AllSimplePaths(g, 1, 1); len(got) != 1 || len(got[0]) != 1 || got[0][0] != 1 {
+		t.Errorf("AllSimplePaths(1, 1) = %v, want [[1]]", got)
+	}
+	if got := AllSimplePaths(g, 1, 3); len(got) != 0 {
+		t.Errorf("AllSimplePaths to an unreachable vertex = %v, want none", got)
+	}
+	if got := AllSimplePaths(g, 1, 9); got != nil {
+		t.Errorf("AllSimplePaths to a missing vertex = %v, want nil", got)
+	}
+}
@@ -0,0 +1,33 @@
+package graph
+
+// IsBipartite 2-colours each component of an undirected graph by BFS. The
+// colouring (0 or 1 per vertex) is returned as a witness, or nil as soon as an
+// odd cycle turns up.
+func IsBipartite(g *Graph) (bool, map[Vertex]int) {
+	color := map[Vertex]int{}
+
+	for _, start := range g.vertices {
+		if _, seen := color[start]; seen {
+			continue
+		}
+
+		color[start] = 0
+		queue := []Vertex{start}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+
+			for _, e := range g.adjacency[v] {
+				c, seen := color[e.To]
+				if !seen {
+					color[e.To] = 1 - color[v]
+					queue = append(queue, e.To)
+				} else if c == color[v] {
+					return false, nil
+				}
+			}
+		}
+	}
+
+	return true, color
+}
@@ -0,0 +1,77 @@
+package graph
+
+import "testing"
+
+func cycleGraph(n int) *Graph {
+	g := NewGraph(false)
+	i := 0
+	for i < n {
+		g.AddEdge(Vertex(i), Vertex((i+1)%n), 1)
+		i++
+	}
+	return g
+}
+
+func checkColoring(t *testing.T, g *Graph, color map[Vertex]int) {
+	t.Helper()
+
+	for _, v := range g.Vertices() {
+		if c, ok := color[v]; !ok || (c != 0 && c != 1) {
+			t.Errorf("vertex %d has colour %d (present %v)", v, c, ok)
+		}
+	}
+	for _, e := range g.Edges() {
+		if color[e.From] == color[e.To] {
+			t.Errorf("edge %d-%d joins two vertices of colour %d", e.From, e.To, color[e.From])
+		}
+	}
+}
+
+func TestIsBipartiteEvenCycle(t *testing.T) {
+	g := cycleGraph(6)
+	ok, color := IsBipartite(g)
+	if !ok {
+		t.Fatalf("even cycle reported as not bipartite")
+	}
+	checkColoring(t, g, color)
+}
+
+func TestIsBipartiteOddCycle(t *testing.T) {
+	ok, color := IsBipartite(cycleGraph(5))
+	if ok || color != nil {
+		t.Errorf("IsBipartite(odd cycle) = %v, %v, want false, nil", ok, color)
+	}
+}
+
+func TestIsBipartiteTree(t *testing.T) {
+	g := NewGraph(false)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(2, 4, 1)
+	g.AddEdge(2, 5, 1)
+	g.AddEdge(3, 6, 1)
+
+	ok, color := IsBipartite(g)
+	if !ok {
+		t.Fatalf("tree reported as not bipartite")
+	}
+	checkColoring(t, g, color)
+}
+
+func TestIsBipartiteDisconnected(t *testing.T) {
+	g := cycleGraph(4)
+	g.AddVertex(10)
+	ok, color := IsBipartite(g)
+	if !ok {
+		t.Fatalf("even cycle plus isolated vertex reported as not bipartite")
+	}
+	checkColoring(t, g, color)
+
+	// A later triangle component must still be found.
+	g.AddEdge(20, 21, 1)
+	g.AddEdge(21, 22, 1)
+	g.AddEdge(22, 20, 1)
+	if ok, color := IsBipartite(g); ok || color != nil {
+		t.Errorf("IsBipartite with a triangle component = %v, %v, want false, nil", ok, color)
+	}
+}
@@ -0,0 +1,89 @@
+package graph
+
+// IsProperColoring reports whether coloring assigns a color to every vertex
+// of g and no edge joins two vertices of the same color. Edge direction is
+// ignored.
+func IsProperColoring(g *Graph, coloring map[Vertex]int) bool {
+	for _, v := range g.vertices {
+		if _, ok := coloring[v]; !ok {
+			return false
+		}
+	}
+
+	for _, e := range g.edges {
+		if coloring[e.From] == coloring[e.To] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsKColorable decides exactly whether g can be properly colored with colors
+// 0..k-1, ignoring edge direction, and returns such a coloring if so. It
+// backtracks, always coloring next the vertex whose neighbours already use the
+// most distinct colors, since it has the fewest options left. A color above
+// every one used so far is only tried once, as any unused color is as good as
+// another. The search is exponential in the worst case.
+func IsKColorable(g *Graph, k int) (map[Vertex]int, bool) {
+	neighbors := map[Vertex][]Vertex{}
+	for _, e := range g.edges {
+		if e.From == e.To {
+			return nil, false
+		}
+		neighbors[e.From] = append(neighbors[e.From], e.To)
+		neighbors[e.To] = append(neighbors[e.To], e.From)
+	}
+
+	coloring := map[Vertex]int{}
+
+	usedColors := func(v Vertex) map[int]bool {
+		used := map[int]bool{}
+		for _, u := range neighbors[v] {
+			if c, ok := coloring[u]; ok {
+				used[c] = true
+			}
+		}
+		return used
+	}
+
+	var search func(highest int) bool
+	search = func(highest int) bool {
+		if len(coloring) == len(g.vertices) {
+			return true
+		}
+
+		var next Vertex
+		var nextUsed map[int]bool
+		found := false
+		for _, v := range g.vertices {
+			if _, ok := coloring[v]; ok {
+				continue
+			}
+			used := usedColors(v)
+			if !found || len(used) > len(nextUsed) ||
+				(len(used) == len(nextUsed) && len(neighbors[v]) > len(neighbors[next])) {
+				next, nextUsed, found = v, used, true
+			}
+		}
+
+		c := 0
+		for c < k && c <= highest+1 {
+			if !nextUsed[c] {
+				coloring[next] = c
+				if search(max(highest, c)) {
+					return true
+				}
+				delete(coloring, next)
+			}
+			c++
+		}
+
+		return false
+	}
+
+	if !search(-1) {
+		return nil, false
+	}
+	return coloring, true
+}
@@ -0,0 +1,154 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// bruteKColorable tries every assignment of k colors to g's vertices.
+func bruteKColorable(g *Graph, k int) bool {
+	vertices := g.Vertices()
+	coloring := map[Vertex]int{}
+
+	var try func(i int) bool
+	try = func(i int) bool {
+		if i == len(vertices) {
+			return IsProperColoring(g, coloring)
+		}
+		c := 0
+		for c < k {
+			coloring[vertices[i]] = c
+			if try(i + 1) {
+				return true
+			}
+			c++
+		}
+		delete(coloring, vertices[i])
+		return false
+	}
+
+	return try(0)
+}
+
+func completeGraph(n int) *Graph {
+	g := NewGraph(false)
+	i := 0
+	for i < n {
+		j := i + 1
+		for j < n {
+			g.AddEdge(Vertex(i), Vertex(j), 1)
+			j++
+		}
+		i++
+	}
+	return g
+}
+
+func checkKColoring(t *testing.T, g *Graph, k int, coloring map[Vertex]int) {
+	t.Helper()
+
+	if !IsProperColoring(g, coloring) {
+		t.Fatalf("IsKColorable(%d) returned improper coloring %v", k, coloring)
+	}
+	for v, c := range coloring {
+		if c < 0 || c >= k {
+			t.Fatalf("vertex %d has color %d, outside 0..%d", v, c, k-1)
+		}
+	}
+}
+
+func TestIsKColorableTriangle(t *testing.T) {
+	g := completeGraph(3)
+
+	if coloring, ok := IsKColorable(g, 2); ok {
+		t.Errorf("IsKColorable(triangle, 2) = %v, want not colorable", coloring)
+	}
+	coloring, ok := IsKColorable(g, 3)
+	if !ok {
+		t.Fatalf("IsKColorable(triangle, 3) found no coloring")
+	}
+	checkKColoring(t, g, 3, coloring)
+}
+
+func TestIsKColorableBipartite(t *testing.T) {
+	for _, g := range []*Graph{cycleGraph(8), pathGraph(5)} {
+		coloring, ok := IsKColorable(g, 2)
+		if !ok {
+			t.Fatalf("IsKColorable(bipartite, 2) found no coloring on %v", g.Edges())
+		}
+		checkKColoring(t, g, 2, coloring)
+	}
+	if _, ok := IsKColorable(cycleGraph(7), 2); ok {
+		t.Errorf("IsKColorable(odd cycle, 2) found a coloring")
+	}
+}
+
+func TestIsKColorableEdgeCases(t *testing.T) {
+	if coloring, ok := IsKColorable(NewGraph(false), 0); !ok || len(coloring) != 0 {
+		t.Errorf("IsKColorable(empty, 0) = %v, %v, want an empty coloring", coloring, ok)
+	}
+	single := NewGraph(false)
+	single.AddVertex(1)
+	if _, ok := IsKColorable(single, 0); ok {
+		t.Errorf("IsKColorable(single vertex, 0) found a coloring")
+	}
+	if _, ok := IsKColorable(completeGraph(5), 4); ok {
+		t.Errorf("IsKColorable(K5, 4) found a coloring")
+	}
+
+	loop := NewGraph(true)
+	loop.AddEdge(1, 1, 1)
+	if _, ok := IsKColorable(loop, 3); ok {
+		t.Errorf("IsKColorable(self-loop, 3) found a coloring")
+	}
+}
+
+func TestIsProperColoring(t *testing.T) {
+	g := pathGraph(3)
+	cases := []struct {
+		coloring map[Vertex]int
+		want     bool
+	}{
+		{map[Vertex]int{0: 0, 1: 1, 2: 0}, true},
+		{map[Vertex]int{0: 0, 1: 0, 2: 1}, false},
+		{map[Vertex]int{0: 0, 1: 1}, false},
+	}
+	for _, c := range cases {
+		if got := IsProperColoring(g, c.coloring); got != c.want {
+			t.Errorf("IsProperColoring(%v) = %v, want %v", c.coloring, got, c.want)
+		}
+	}
+}
+
+func TestIsKColorableMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(276))
+
+	trial := 0
+	for trial < 300 {
+		g := NewGraph(trial%2 == 0)
+		n := 1 + r.Intn(7)
+		i := 0
+		for i < n {
+			g.AddVertex(Vertex(i))
+			i++
+		}
+		edges := r.Intn(3 * n)
+		for edges > 0 {
+			from, to := r.Intn(n), r.Intn(n)
+			if from != to {
+				g.AddEdge(Vertex(from), Vertex(to), 1)
+			}
+			edges--
+		}
+
+		k := 1 + r.Intn(4)
+		coloring, ok := IsKColorable(g, k)
+		if want := bruteKColorable(g, k); ok != want {
+			t.Fatalf("IsKColorable(%v, %d) = %v, want %v", g.Edges(), k, ok, want)
+		}
+		if ok {
+			checkKColoring(t, g, k, coloring)
+		}
+		trial++
+	}
+}
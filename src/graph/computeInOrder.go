@@ -0,0 +1,29 @@
+package graph
+
+// ComputeInOrder evaluates compute once per vertex of a DAG, after all of the
+// vertex's predecessors, passing their results in the order of the incoming
+// edges. A cyclic graph yields ErrCycle.
+func ComputeInOrder[V any](g *Graph, compute func(vertex Vertex, depResults []V) V) (map[Vertex]V, error) {
+	order, err := TopologicalSort(g)
+	if err != nil {
+		return nil, err
+	}
+
+	predecessors := map[Vertex][]Vertex{}
+	for _, v := range g.vertices {
+		for _, e := range g.adjacency[v] {
+			predecessors[e.To] = append(predecessors[e.To], v)
+		}
+	}
+
+	results := make(map[Vertex]V, len(order))
+	for _, v := range order {
+		deps := make([]V, len(predecessors[v]))
+		for i, p := range predecessors[v] {
+			deps[i] = results[p]
+		}
+		results[v] = compute(v, deps)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,78 @@
+package graph
+
+import "testing"
+
+func TestComputeInOrder(t *testing.T) {
+	// 1 → 2 → 4, 1 → 3 → 4, 4 → 5 and an isolated 6.
+	g := NewGraph(true)
+	g.AddEdge(1, 2, 0)
+	g.AddEdge(1, 3, 0)
+	g.AddEdge(2, 4, 0)
+	g.AddEdge(3, 4, 0)
+	g.AddEdge(4, 5, 0)
+	g.AddVertex(6)
+
+	calls := map[Vertex]int{}
+	results, err := ComputeInOrder(g, func(v Vertex, deps []int) int {
+		calls[v]++
+		sum := 1
+		for _, d := range deps {
+			sum += d
+		}
+		return sum
+	})
+	if err != nil {
+		t.Fatalf("ComputeInOrder: %v", err)
+	}
+
+	want := map[Vertex]int{1: 1, 2: 2, 3: 2, 4: 5, 5: 6, 6: 1}
+	for v, w := range want {
+		if results[v] != w {
+			t.Errorf("result[%d] = %d, want %d", v, results[v], w)
+		}
+		if calls[v] != 1 {
+			t.Errorf("compute called %d times for %d, want once", calls[v], v)
+		}
+	}
+	if len(results) != len(want) {
+		t.Errorf("got %d results, want %d", len(results), len(want))
+	}
+}
+
+func TestComputeInOrderSeesDependencies(t *testing.T) {
+	g := NewGraph(true)
+	g.AddEdge(1, 3, 0)
+	g.AddEdge(2, 3, 0)
+
+	done := map[Vertex]bool{}
+	_, err := ComputeInOrder(g, func(v Vertex, deps []bool) bool {
+		for _, e := range g.Edges() {
+			if e.To == v && !done[e.From] {
+				t.Errorf("vertex %d computed before its dependency %d", v, e.From)
+			}
+		}
+		if v == 3 && len(deps) != 2 {
+			t.Errorf("vertex 3 got %d dependency results, want 2", len(deps))
+		}
+		done[v] = true
+		return true
+	})
+	if err != nil {
+		t.Fatalf("ComputeInOrder: %v", err)
+	}
+}
+
+func TestComputeInOrderCycle(t *testing.T) {
+	g := NewGraph(true)
+	g.AddEdge(1, 2, 0)
+	g.AddEdge(2, 3, 0)
+	g.AddEdge(3, 1, 0)
+
+	results, err := ComputeInOrder(g, func(Vertex, []int) int {
+		t.Errorf("compute called on a cyclic graph")
+		return 0
+	})
+	if err != ErrCycle || results != nil {
+		t.Errorf("ComputeInOrder(cycle) = %v, %v, want nil, ErrCycle", results, err)
+	}
+}
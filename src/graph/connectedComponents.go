@@ -0,0 +1,56 @@
+package graph
+
+func componentLabels(g *Graph) (map[Vertex]int, int) {
+	labels := map[Vertex]int{}
+	count := 0
+
+	for _, start := range g.vertices {
+		if _, seen := labels[start]; seen {
+			continue
+		}
+
+		labels[start] = count
+		queue := []Vertex{start}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+
+			for _, e := range g.adjacency[v] {
+				if _, seen := labels[e.To]; !seen {
+					labels[e.To] = count
+					queue = append(queue, e.To)
+				}
+			}
+		}
+
+		count++
+	}
+
+	return labels, count
+}
+
+// ConnectedComponents groups the vertices of an undirected graph by component,
+// in order of each component's first vertex.
+func ConnectedComponents(g *Graph) [][]Vertex {
+	labels, count := componentLabels(g)
+	components := make([][]Vertex, count)
+
+	for _, v := range g.vertices {
+		components[labels[v]] = append(components[labels[v]], v)
+	}
+
+	return components
+}
+
+func ComponentCount(g *Graph) int {
+	_, count := componentLabels(g)
+	return count
+}
+
+func SameComponent(g *Graph, u, v Vertex) bool {
+	labels, _ := componentLabels(g)
+
+	lu, okU := labels[u]
+	lv, okV := labels[v]
+	return okU && okV && lu == lv
+}
@@ -0,0 +1,50 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConnectedComponents(t *testing.T) {
+	g := NewGraph(false)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(4, 5, 1)
+	g.AddVertex(6)
+	g.AddEdge(3, 1, 1)
+
+	want := [][]Vertex{{1, 2, 3}, {4, 5}, {6}}
+	if got := ConnectedComponents(g); !reflect.DeepEqual(got, want) {
+		t.Errorf("ConnectedComponents = %v, want %v", got, want)
+	}
+	if got := ComponentCount(g); got != 3 {
+		t.Errorf("ComponentCount = %d, want 3", got)
+	}
+
+	cases := []struct {
+		u, v Vertex
+		want bool
+	}{
+		{1, 3, true},
+		{4, 5, true},
+		{6, 6, true},
+		{1, 4, false},
+		{5, 6, false},
+		{1, 99, false},
+	}
+	for _, c := range cases {
+		if got := SameComponent(g, c.u, c.v); got != c.want {
+			t.Errorf("SameComponent(%d, %d) = %v, want %v", c.u, c.v, got, c.want)
+		}
+	}
+}
+
+func TestConnectedComponentsEmpty(t *testing.T) {
+	g := NewGraph(false)
+	if got := ConnectedComponents(g); len(got) != 0 {
+		t.Errorf("ConnectedComponents(empty) = %v, want none", got)
+	}
+	if got := ComponentCount(g); got != 0 {
+		t.Errorf("ComponentCount(empty) = %d, want 0", got)
+	}
+}
@@ -0,0 +1,60 @@
+package graph
+
+import "math"
+
+// INFINITE_DISTANCE is reported when some vertex cannot be reached.
+const INFINITE_DISTANCE = math.MaxInt
+
+// Eccentricity is the largest shortest-path distance from v to any other
+// vertex, or INFINITE_DISTANCE if some vertex is unreachable from v.
+func Eccentricity(g *Graph, v Vertex) int {
+	if !g.HasVertex(v) {
+		return INFINITE_DISTANCE
+	}
+
+	distances := ShortestDistances(g, v)
+	if len(distances) < len(g.vertices) {
+		return INFINITE_DISTANCE
+	}
+
+	eccentricity := 0
+	for _, d := range distances {
+		if d > eccentricity {
+			eccentricity = d
+		}
+	}
+
+	return eccentricity
+}
+
+// Diameter is the largest eccentricity of any vertex, which is
+// INFINITE_DISTANCE for a disconnected graph. It runs one Dijkstra per vertex.
+func Diameter(g *Graph) int {
+	diameter := 0
+	for _, v := range g.vertices {
+		if e := Eccentricity(g, v); e > diameter {
+			diameter = e
+		}
+	}
+
+	return diameter
+}
+
+// Center returns the vertices of minimum eccentricity. In a disconnected graph
+// every eccentricity is infinite, so every vertex is returned.
+func Center(g *Graph) []Vertex {
+	var center []Vertex
+	best := 0
+
+	for _, v := range g.vertices {
+		e := Eccentricity(g, v)
+		if center == nil || e < best {
+			center = []Vertex{v}
+			best = e
+		} else if e == best {
+			center = append(center, v)
+		}
+	}
+
+	return center
+}
@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func pathGraph(n int) *Graph {
+	g := NewGraph(false)
+	g.AddVertex(0)
+	i := 1
+	for i < n {
+		g.AddEdge(Vertex(i-1), Vertex(i), 1)
+		i++
+	}
+	return g
+}
+
+func TestDiameterPath(t *testing.T) {
+	g := pathGraph(5)
+
+	if got := Diameter(g); got != 4 {
+		t.Errorf("Diameter = %d, want 4", got)
+	}
+	if got := Center(g); !reflect.DeepEqual(got, []Vertex{2}) {
+		t.Errorf("Center = %v, want [2]", got)
+	}
+	for v, want := range []int{4, 3, 2, 3, 4} {
+		if got := Eccentricity(g, Vertex(v)); got != want {
+			t.Errorf("Eccentricity(%d) = %d, want %d", v, got, want)
+		}
+	}
+
+	if got := Center(pathGraph(4)); !reflect.DeepEqual(got, []Vertex{1, 2}) {
+		t.Errorf("Center of a 4-vertex path = %v, want [1 2]", got)
+	}
+}
+
+func TestDiameterStar(t *testing.T) {
+	g := NewGraph(false)
+	leaf := Vertex(1)
+	for leaf <= 6 {
+		g.AddEdge(0, leaf, 1)
+		leaf++
+	}
+
+	if got := Diameter(g); got != 2 {
+		t.Errorf("Diameter = %d, want 2", got)
+	}
+	if got := Center(g); !reflect.DeepEqual(got, []Vertex{0}) {
+		t.Errorf("Center = %v, want the hub [0]", got)
+	}
+}
+
+func TestDiameterWeighted(t *testing.T) {
+	// The direct 0-2 edge is longer than the detour through 1.
+	g := NewGraph(false)
+	g.AddEdge(0, 1, 2)
+	g.AddEdge(1, 2, 3)
+	g.AddEdge(0, 2, 10)
+
+	want := map[Vertex]int{0: 0, 1: 2, 2: 5}
+	if got := ShortestDistances(g, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("ShortestDistances(0) = %v, want %v", got, want)
+	}
+	if got := Diameter(g); got != 5 {
+		t.Errorf("Diameter = %d, want 5", got)
+	}
+}
+
+func TestDiameterDisconnected(t *testing.T) {
+	g := pathGraph(3)
+	g.AddVertex(10)
+
+	if got := Diameter(g); got != INFINITE_DISTANCE {
+		t.Errorf("Diameter = %d, want INFINITE_DISTANCE", got)
+	}
+	if got := Eccentricity(g, 0); got != INFINITE_DISTANCE {
+		t.Errorf("Eccentricity(0) = %d, want INFINITE_DISTANCE", got)
+	}
+	if got := Center(g); len(got) != 4 {
+		t.Errorf("Center = %v, want every vertex", got)
+	}
+	if got := Eccentricity(g, 99); got != INFINITE_DISTANCE {
+		t.Errorf("Eccentricity of a missing vertex = %d, want INFINITE_DISTANCE", got)
+	}
+}
+
+func TestDiameterTrivial(t *testing.T) {
+	if got := Diameter(NewGraph(false)); got != 0 {
+		t.Errorf("Diameter(empty) = %d, want 0", got)
+	}
+	if got := Center(NewGraph(false)); got != nil {
+		t.Errorf("Center(empty) = %v, want nil", got)
+	}
+	if got := Diameter(pathGraph(1)); got != 0 {
+		t.Errorf("Diameter(single vertex) = %d, want 0", got)
+	}
+}
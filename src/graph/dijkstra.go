@@ -0,0 +1,64 @@
+package graph
+
+import "container/heap"
+
+type distanceItem struct {
+	vertex   Vertex
+	distance int
+}
+
+type distanceHeap []distanceItem
+
+func (h distanceHeap) Len() int {
+	return len(h)
+}
+
+func (h distanceHeap) Less(i, j int) bool {
+	return h[i].distance < h[j].distance
+}
+
+func (h distanceHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *distanceHeap) Push(x any) {
+	*h = append(*h, x.(distanceItem))
+}
+
+func (h *distanceHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+// ShortestDistances runs Dijkstra from src and returns the distance to every
+// reachable vertex. Edge weights must be non-negative.
+func ShortestDistances(g *Graph, src Vertex) map[Vertex]int {
+	distances := map[Vertex]int{}
+	if !g.HasVertex(src) {
+		return distances
+	}
+
+	distances[src] = 0
+	h := &distanceHeap{{vertex: src}}
+	done := map[Vertex]bool{}
+
+	for h.Len() > 0 {
+		item := heap.Pop(h).(distanceItem)
+		if done[item.vertex] {
+			continue
+		}
+		done[item.vertex] = true
+
+		for _, e := range g.adjacency[item.vertex] {
+			d := item.distance + e.Weight
+			if current, ok := distances[e.To]; !ok || d < current {
+				distances[e.To] = d
+				heap.Push(h, distanceItem{vertex: e.To, distance: d})
+			}
+		}
+	}
+
+	return distances
+}
@@ -0,0 +1,49 @@
+package graph
+
+// FindCycle returns one cycle of g as a vertex sequence in which each vertex
+// has an edge to the next and the last has one back to the first, or nil and
+// false if g is acyclic. A depth-first search reports the first edge that
+// leads back to a vertex still on the search stack. In an undirected graph
+// the edge a vertex was reached through does not count, so a cycle there has
+// at least three vertices unless there are parallel edges or self-loops.
+func FindCycle(g *Graph) ([]Vertex, bool) {
+	onStack := map[Vertex]int{}
+	done := map[Vertex]bool{}
+	var stack []Vertex
+	var cycle []Vertex
+
+	var explore func(v, parent Vertex, hasParent bool) bool
+	explore = func(v, parent Vertex, hasParent bool) bool {
+		onStack[v] = len(stack)
+		stack = append(stack, v)
+
+		skippedParent := false
+		for _, e := range g.adjacency[v] {
+			if !g.directed && hasParent && e.To == parent && !skippedParent {
+				skippedParent = true
+				continue
+			}
+
+			if i, ok := onStack[e.To]; ok {
+				cycle = append([]Vertex{}, stack[i:]...)
+				return true
+			}
+			if !done[e.To] && explore(e.To, v, true) {
+				return true
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		delete(onStack, v)
+		done[v] = true
+		return false
+	}
+
+	for _, v := range g.vertices {
+		if !done[v] && explore(v, 0, false) {
+			return cycle, true
+		}
+	}
+
+	return nil, false
+}
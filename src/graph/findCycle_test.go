@@ -0,0 +1,124 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func hasEdge(g *Graph, from, to Vertex) bool {
+	for _, e := range g.Neighbors(from) {
+		if e.To == to {
+			return true
+		}
+	}
+	return false
+}
+
+func checkCycle(t *testing.T, g *Graph, cycle []Vertex) {
+	t.Helper()
+
+	if len(cycle) == 0 {
+		t.Fatalf("FindCycle returned an empty cycle")
+	}
+	seen := map[Vertex]bool{}
+	for i, v := range cycle {
+		if seen[v] {
+			t.Fatalf("cycle %v repeats vertex %d", cycle, v)
+		}
+		seen[v] = true
+		if next := cycle[(i+1)%len(cycle)]; !hasEdge(g, v, next) {
+			t.Fatalf("cycle %v uses missing edge %d → %d", cycle, v, next)
+		}
+	}
+}
+
+func TestFindCycleDirected(t *testing.T) {
+	// A tail 1 → 2 leads into the cycle 2 → 3 → 4 → 2, with 4 → 5 hanging off.
+	g := NewGraph(true)
+	g.AddEdge(1, 2, 0)
+	g.AddEdge(2, 3, 0)
+	g.AddEdge(3, 4, 0)
+	g.AddEdge(4, 2, 0)
+	g.AddEdge(4, 5, 0)
+
+	cycle, ok := FindCycle(g)
+	if !ok {
+		t.Fatalf("FindCycle found no cycle")
+	}
+	checkCycle(t, g, cycle)
+	if len(cycle) != 3 {
+		t.Errorf("FindCycle = %v, want the three vertices 2, 3 and 4", cycle)
+	}
+}
+
+func TestFindCycleAcyclic(t *testing.T) {
+	g := NewGraph(true)
+	g.AddEdge(1, 2, 0)
+	g.AddEdge(1, 3, 0)
+	g.AddEdge(2, 4, 0)
+	g.AddEdge(3, 4, 0)
+
+	if cycle, ok := FindCycle(g); ok || cycle != nil {
+		t.Errorf("FindCycle(DAG) = %v, %v, want nil, false", cycle, ok)
+	}
+	if cycle, ok := FindCycle(NewGraph(true)); ok || cycle != nil {
+		t.Errorf("FindCycle(empty) = %v, %v, want nil, false", cycle, ok)
+	}
+}
+
+func TestFindCycleUndirected(t *testing.T) {
+	if cycle, ok := FindCycle(pathGraph(5)); ok {
+		t.Errorf("FindCycle(path) = %v, want no cycle", cycle)
+	}
+
+	g := cycleGraph(5)
+	cycle, ok := FindCycle(g)
+	if !ok {
+		t.Fatalf("FindCycle(5-cycle) found no cycle")
+	}
+	checkCycle(t, g, cycle)
+}
+
+func TestFindCycleSelfLoop(t *testing.T) {
+	g := NewGraph(true)
+	g.AddEdge(1, 2, 0)
+	g.AddEdge(2, 2, 0)
+
+	cycle, ok := FindCycle(g)
+	if !ok {
+		t.Fatalf("FindCycle found no self-loop")
+	}
+	checkCycle(t, g, cycle)
+}
+
+// TestFindCycleMatchesTopologicalSort checks random digraphs against
+// TopologicalLevels, which fails exactly when a digraph has a cycle.
+func TestFindCycleMatchesTopologicalSort(t *testing.T) {
+	r := rand.New(rand.NewSource(271))
+
+	trial := 0
+	for trial < 300 {
+		n := 1 + r.Intn(8)
+		g := NewGraph(true)
+		i := 0
+		for i < n {
+			g.AddVertex(Vertex(i))
+			i++
+		}
+		edges := r.Intn(2 * n)
+		for edges > 0 {
+			g.AddEdge(Vertex(r.Intn(n)), Vertex(r.Intn(n)), 0)
+			edges--
+		}
+
+		cycle, ok := FindCycle(g)
+		_, err := TopologicalLevels(g)
+		if ok != (err == ErrCycle) {
+			t.Fatalf("FindCycle = %v, %v but TopologicalLevels error is %v on %v", cycle, ok, err, g.Edges())
+		}
+		if ok {
+			checkCycle(t, g, cycle)
+		}
+		trial++
+	}
+}
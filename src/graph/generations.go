@@ -0,0 +1,25 @@
+package graph
+
+// Generations groups the vertices of a DAG into the rounds Kahn's algorithm
+// would peel them off in: generation 0 holds the sources, and each later
+// generation the vertices left without predecessors once the earlier ones are
+// removed. A vertex's generation is therefore its TopologicalLevels level.
+// Vertices in a generation keep insertion order. It returns ErrCycle for a
+// cyclic graph.
+func Generations(g *Graph) ([][]Vertex, error) {
+	levels, err := TopologicalLevels(g)
+	if err != nil {
+		return nil, err
+	}
+
+	var generations [][]Vertex
+	for _, v := range g.vertices {
+		level := levels[v]
+		for len(generations) <= level {
+			generations = append(generations, nil)
+		}
+		generations[level] = append(generations[level], v)
+	}
+
+	return generations, nil
+}
@@ -0,0 +1,46 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGenerations(t *testing.T) {
+	// 1 and 2 are sources; 3 needs both, 4 needs only 1, 5 needs 3 and 4,
+	// and 6 is isolated.
+	g := NewGraph(true)
+	g.AddEdge(1, 3, 0)
+	g.AddEdge(2, 3, 0)
+	g.AddEdge(1, 4, 0)
+	g.AddEdge(3, 5, 0)
+	g.AddEdge(4, 5, 0)
+	g.AddVertex(6)
+	g.AddEdge(1, 5, 0)
+
+	got, err := Generations(g)
+	if err != nil {
+		t.Fatalf("Generations: %v", err)
+	}
+	want := [][]Vertex{{1, 2, 6}, {3, 4}, {5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Generations = %v, want %v", got, want)
+	}
+}
+
+func TestGenerationsEmpty(t *testing.T) {
+	got, err := Generations(NewGraph(true))
+	if err != nil || len(got) != 0 {
+		t.Errorf("Generations(empty) = %v, %v, want none", got, err)
+	}
+}
+
+func TestGenerationsCycle(t *testing.T) {
+	g := NewGraph(true)
+	g.AddEdge(1, 2, 0)
+	g.AddEdge(2, 3, 0)
+	g.AddEdge(3, 2, 0)
+
+	if got, err := Generations(g); err != ErrCycle || got != nil {
+		t.Errorf("Generations(cycle) = %v, %v, want nil, ErrCycle", got, err)
+	}
+}
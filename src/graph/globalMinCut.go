@@ -0,0 +1,90 @@
+package graph
+
+import "math"
+
+// GlobalMinCut runs Stoer-Wagner on an undirected weighted graph and returns
+// the weight of a minimum cut together with the vertices on one side of it.
+// Graphs with fewer than two vertices have no cut and yield 0 and nil.
+func GlobalMinCut(g *Graph) (cut int, partition []Vertex) {
+	n := len(g.vertices)
+	if n < 2 {
+		return 0, nil
+	}
+
+	index := map[Vertex]int{}
+	for i, v := range g.vertices {
+		index[v] = i
+	}
+
+	weight := make([][]int, n)
+	groups := make([][]Vertex, n)
+	i := 0
+	for i < n {
+		weight[i] = make([]int, n)
+		groups[i] = []Vertex{g.vertices[i]}
+		i++
+	}
+
+	for _, e := range g.edges {
+		u, v := index[e.From], index[e.To]
+		if u != v {
+			weight[u][v] += e.Weight
+			weight[v][u] += e.Weight
+		}
+	}
+
+	active := make([]int, n)
+	i = 0
+	for i < n {
+		active[i] = i
+		i++
+	}
+
+	cut = math.MaxInt
+	for len(active) > 1 {
+		added := make([]bool, n)
+		connectivity := make([]int, n)
+		prev, last := -1, -1
+
+		step := 0
+		for step < len(active) {
+			next := -1
+			for _, v := range active {
+				if !added[v] && (next == -1 || connectivity[v] > connectivity[next]) {
+					next = v
+				}
+			}
+
+			added[next] = true
+			prev, last = last, next
+			for _, v := range active {
+				if !added[v] {
+					connectivity[v] += weight[next][v]
+				}
+			}
+			step++
+		}
+
+		if connectivity[last] < cut {
+			cut = connectivity[last]
+			partition = append([]Vertex{}, groups[last]...)
+		}
+
+		groups[prev] = append(groups[prev], groups[last]...)
+		for _, v := range active {
+			weight[prev][v] += weight[last][v]
+			weight[v][prev] = weight[prev][v]
+		}
+		weight[prev][prev] = 0
+
+		remaining := active[:0]
+		for _, v := range active {
+			if v != last {
+				remaining = append(remaining, v)
+			}
+		}
+		active = remaining
+	}
+
+	return cut, partition
+}
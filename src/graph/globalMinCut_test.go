@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func crossingWeight(g *Graph, side []Vertex) int {
+	in := map[Vertex]bool{}
+	for _, v := range side {
+		in[v] = true
+	}
+
+	weight := 0
+	for _, e := range g.Edges() {
+		if in[e.From] != in[e.To] {
+			weight += e.Weight
+		}
+	}
+	return weight
+}
+
+func bruteMinCut(g *Graph) int {
+	vertices := g.Vertices()
+	best := math.MaxInt
+
+	mask := 1
+	for mask < 1<<(len(vertices)-1) {
+		var side []Vertex
+		for i, v := range vertices {
+			if mask&(1<<i) != 0 {
+				side = append(side, v)
+			}
+		}
+		if w := crossingWeight(g, side); w < best {
+			best = w
+		}
+		mask++
+	}
+	return best
+}
+
+func checkPartition(t *testing.T, g *Graph, cut int, partition []Vertex) {
+	t.Helper()
+
+	if len(partition) == 0 || len(partition) == g.Order() {
+		t.Fatalf("partition %v is not a proper subset of %d vertices", partition, g.Order())
+	}
+	if w := crossingWeight(g, partition); w != cut {
+		t.Errorf("partition %v crosses weight %d, reported cut %d", partition, w, cut)
+	}
+}
+
+func TestGlobalMinCutKnown(t *testing.T) {
+	// Two heavy triangles joined by edges of weight 1 and 2.
+	g := NewGraph(false)
+	g.AddEdge(1, 2, 5)
+	g.AddEdge(2, 3, 5)
+	g.AddEdge(3, 1, 5)
+	g.AddEdge(4, 5, 5)
+	g.AddEdge(5, 6, 5)
+	g.AddEdge(6, 4, 5)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(1, 6, 2)
+
+	cut, partition := GlobalMinCut(g)
+	if cut != 3 {
+		t.Errorf("GlobalMinCut = %d, want 3", cut)
+	}
+	checkPartition(t, g, cut, partition)
+}
+
+func TestGlobalMinCutRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(220))
+
+	trial := 0
+	for trial < 200 {
+		n := 2 + r.Intn(6)
+		g := NewGraph(false)
+		i := 0
+		for i < n {
+			g.AddVertex(Vertex(i))
+			i++
+		}
+		edges := r.Intn(n * 2)
+		i = 0
+		for i < edges {
+			g.AddEdge(Vertex(r.Intn(n)), Vertex(r.Intn(n)), 1+r.Intn(9))
+			i++
+		}
+
+		cut, partition := GlobalMinCut(g)
+		if want := bruteMinCut(g); cut != want {
+			t.Fatalf("edges %v: GlobalMinCut = %d, want %d", g.Edges(), cut, want)
+		}
+		checkPartition(t, g, cut, partition)
+		trial++
+	}
+}
+
+func TestGlobalMinCutTooSmall(t *testing.T) {
+	g := NewGraph(false)
+	g.AddVertex(1)
+	if cut, partition := GlobalMinCut(g); cut != 0 || partition != nil {
+		t.Errorf("GlobalMinCut(single vertex) = %d, %v, want 0, nil", cut, partition)
+	}
+}
@@ -0,0 +1,76 @@
+package graph
+
+type Vertex int
+
+type Edge struct {
+	From, To Vertex
+	Weight   int
+}
+
+// Graph keeps vertices and edges in insertion order so that every traversal
+// over it is deterministic. In an undirected graph each edge is listed once by
+// Edges, but appears in the neighbour lists of both of its endpoints.
+type Graph struct {
+	directed  bool
+	vertices  []Vertex
+	edges     []Edge
+	adjacency map[Vertex][]Edge
+}
+
+func NewGraph(directed bool) *Graph {
+	return &Graph{
+		directed:  directed,
+		adjacency: map[Vertex][]Edge{},
+	}
+}
+
+func (g *Graph) Directed() bool {
+	return g.directed
+}
+
+func (g *Graph) AddVertex(v Vertex) {
+	if _, ok := g.adjacency[v]; ok {
+		return
+	}
+
+	g.vertices = append(g.vertices, v)
+	g.adjacency[v] = []Edge{}
+}
+
+func (g *Graph) AddEdge(from, to Vertex, weight int) {
+	g.AddVertex(from)
+	g.AddVertex(to)
+
+	e := Edge{From: from, To: to, Weight: weight}
+	g.edges = append(g.edges, e)
+	g.adjacency[from] = append(g.adjacency[from], e)
+
+	if !g.directed && from != to {
+		g.adjacency[to] = append(g.adjacency[to], Edge{From: to, To: from, Weight: weight})
+	}
+}
+
+func (g *Graph) HasVertex(v Vertex) bool {
+	_, ok := g.adjacency[v]
+	return ok
+}
+
+func (g *Graph) Vertices() []Vertex {
+	vertices := make([]Vertex, len(g.vertices))
+	copy(vertices, g.vertices)
+	return vertices
+}
+
+func (g *Graph) Edges() []Edge {
+	edges := make([]Edge, len(g.edges))
+	copy(edges, g.edges)
+	return edges
+}
+
+func (g *Graph) Neighbors(v Vertex) []Edge {
+	return g.adjacency[v]
+}
+
+func (g *Graph) Order() int {
+	return len(g.vertices)
+}
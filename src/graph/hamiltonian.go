@@ -0,0 +1,118 @@
+package graph
+
+type hamiltonState struct {
+	visited uint64
+	current int
+}
+
+// hamiltonian backtracks over extensions of a path that starts at each of
+// starts in turn, and returns the first path through every vertex for which
+// accept holds. Self-loops and parallel edges are irrelevant to the search and
+// dropped. On graphs of up to 64 vertices a (visited set, endpoint) pair that
+// has already failed is never explored again, which turns the worst case from
+// O(n!) into O(2^n n^2).
+func hamiltonian(g *Graph, starts []Vertex, accept func(path []Vertex) bool) ([]Vertex, bool) {
+	n := len(g.vertices)
+	index := map[Vertex]int{}
+	for i, v := range g.vertices {
+		index[v] = i
+	}
+
+	neighbors := make([][]int, n)
+	for i, v := range g.vertices {
+		seen := map[int]bool{i: true}
+		for _, e := range g.adjacency[v] {
+			if j := index[e.To]; !seen[j] {
+				seen[j] = true
+				neighbors[i] = append(neighbors[i], j)
+			}
+		}
+	}
+
+	visited := make([]bool, n)
+	var mask uint64
+	failed := map[hamiltonState]bool{}
+	path := make([]Vertex, 0, n)
+
+	var extend func(current int) bool
+	extend = func(current int) bool {
+		if len(path) == n {
+			return accept(path)
+		}
+
+		state := hamiltonState{visited: mask, current: current}
+		if n <= 64 && failed[state] {
+			return false
+		}
+
+		for _, next := range neighbors[current] {
+			if visited[next] {
+				continue
+			}
+
+			visited[next] = true
+			mask |= 1 << uint(next)
+			path = append(path, g.vertices[next])
+			if extend(next) {
+				return true
+			}
+			path = path[:len(path)-1]
+			mask &^= 1 << uint(next)
+			visited[next] = false
+		}
+
+		if n <= 64 {
+			failed[state] = true
+		}
+		return false
+	}
+
+	for _, start := range starts {
+		i := index[start]
+		visited[i] = true
+		mask = 1 << uint(i)
+		path = append(path[:0], start)
+		if extend(i) {
+			return path, true
+		}
+		visited[i] = false
+	}
+
+	return nil, false
+}
+
+// HamiltonianPath returns an order of g's vertices, each visited exactly
+// once, in which consecutive vertices are joined by an edge. The problem is
+// NP-complete and this exhaustive search is exponential, so it is only meant
+// for small graphs.
+func HamiltonianPath(g *Graph) ([]Vertex, bool) {
+	return hamiltonian(g, g.vertices, func(path []Vertex) bool {
+		return true
+	})
+}
+
+// HamiltonianCycle is HamiltonianPath with an additional edge from the last
+// vertex back to the first, which is not repeated at the end. As with
+// FindCycle, an undirected cycle cannot use the same edge twice, so two
+// vertices only form a cycle when joined by parallel edges and a single vertex
+// only with a self-loop. The same small-graph caveat applies.
+func HamiltonianCycle(g *Graph) ([]Vertex, bool) {
+	if len(g.vertices) == 0 {
+		return nil, false
+	}
+
+	needed := 1
+	if !g.directed && len(g.vertices) == 2 {
+		needed = 2
+	}
+
+	return hamiltonian(g, g.vertices[:1], func(path []Vertex) bool {
+		closing := 0
+		for _, e := range g.adjacency[path[len(path)-1]] {
+			if e.To == path[0] {
+				closing++
+			}
+		}
+		return closing >= needed
+	})
+}
@@ -0,0 +1,180 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func checkHamiltonian(t *testing.T, g *Graph, path []Vertex, closed bool) {
+	t.Helper()
+
+	if len(path) != g.Order() {
+		t.Fatalf("path %v has %d vertices, want %d", path, len(path), g.Order())
+	}
+	seen := map[Vertex]bool{}
+	for i, v := range path {
+		if seen[v] || !g.HasVertex(v) {
+			t.Fatalf("path %v repeats or invents vertex %d", path, v)
+		}
+		seen[v] = true
+		if i+1 < len(path) && !hasEdge(g, v, path[i+1]) {
+			t.Fatalf("path %v uses missing edge %d → %d", path, v, path[i+1])
+		}
+	}
+	if closed && !hasEdge(g, path[len(path)-1], path[0]) {
+		t.Fatalf("cycle %v has no closing edge %d → %d", path, path[len(path)-1], path[0])
+	}
+}
+
+// bruteHamiltonian tries every order of g's vertices.
+func bruteHamiltonian(g *Graph, closed bool) bool {
+	vertices := g.Vertices()
+	n := len(vertices)
+	used := make([]bool, n)
+	order := make([]Vertex, 0, n)
+
+	var try func() bool
+	try = func() bool {
+		if len(order) == n {
+			if !closed {
+				return true
+			}
+			if n == 2 && !g.Directed() {
+				count := 0
+				for _, e := range g.Neighbors(order[1]) {
+					if e.To == order[0] {
+						count++
+					}
+				}
+				return count >= 2
+			}
+			return hasEdge(g, order[n-1], order[0])
+		}
+		for i, v := range vertices {
+			if used[i] {
+				continue
+			}
+			if len(order) > 0 && (order[len(order)-1] == v || !hasEdge(g, order[len(order)-1], v)) {
+				continue
+			}
+			used[i] = true
+			order = append(order, v)
+			if try() {
+				return true
+			}
+			order = order[:len(order)-1]
+			used[i] = false
+		}
+		return false
+	}
+
+	return try()
+}
+
+func TestHamiltonianCycleGraph(t *testing.T) {
+	g := cycleGraph(7)
+
+	cycle, ok := HamiltonianCycle(g)
+	if !ok {
+		t.Fatalf("HamiltonianCycle(7-cycle) found nothing")
+	}
+	checkHamiltonian(t, g, cycle, true)
+
+	path, ok := HamiltonianPath(g)
+	if !ok {
+		t.Fatalf("HamiltonianPath(7-cycle) found nothing")
+	}
+	checkHamiltonian(t, g, path, false)
+}
+
+func TestHamiltonianStar(t *testing.T) {
+	// A star with three leaves: any path through the centre reaches at most
+	// two of them.
+	g := NewGraph(false)
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(0, 3, 1)
+
+	if path, ok := HamiltonianPath(g); ok {
+		t.Errorf("HamiltonianPath(star) = %v, want none", path)
+	}
+	if cycle, ok := HamiltonianCycle(g); ok {
+		t.Errorf("HamiltonianCycle(star) = %v, want none", cycle)
+	}
+}
+
+func TestHamiltonianPathNotCycle(t *testing.T) {
+	g := pathGraph(6)
+
+	path, ok := HamiltonianPath(g)
+	if !ok {
+		t.Fatalf("HamiltonianPath(path) found nothing")
+	}
+	checkHamiltonian(t, g, path, false)
+	if cycle, ok := HamiltonianCycle(g); ok {
+		t.Errorf("HamiltonianCycle(path) = %v, want none", cycle)
+	}
+}
+
+func TestHamiltonianSmall(t *testing.T) {
+	if _, ok := HamiltonianCycle(NewGraph(true)); ok {
+		t.Errorf("HamiltonianCycle(empty) found a cycle")
+	}
+
+	single := NewGraph(false)
+	single.AddVertex(1)
+	if path, ok := HamiltonianPath(single); !ok || len(path) != 1 {
+		t.Errorf("HamiltonianPath(single vertex) = %v, %v, want [1], true", path, ok)
+	}
+	if _, ok := HamiltonianCycle(single); ok {
+		t.Errorf("HamiltonianCycle(single vertex without a self-loop) found a cycle")
+	}
+
+	edge := NewGraph(false)
+	edge.AddEdge(1, 2, 1)
+	if _, ok := HamiltonianCycle(edge); ok {
+		t.Errorf("HamiltonianCycle(single edge) reused the edge")
+	}
+	edge.AddEdge(2, 1, 1)
+	if _, ok := HamiltonianCycle(edge); !ok {
+		t.Errorf("HamiltonianCycle(parallel edges) found no cycle")
+	}
+}
+
+func TestHamiltonianMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(275))
+
+	trial := 0
+	for trial < 300 {
+		directed := trial%2 == 0
+		g := NewGraph(directed)
+		n := 1 + r.Intn(7)
+		i := 0
+		for i < n {
+			g.AddVertex(Vertex(i))
+			i++
+		}
+		edges := r.Intn(2 * n)
+		for edges > 0 {
+			g.AddEdge(Vertex(r.Intn(n)), Vertex(r.Intn(n)), 1)
+			edges--
+		}
+
+		path, ok := HamiltonianPath(g)
+		if want := bruteHamiltonian(g, false); ok != want {
+			t.Fatalf("HamiltonianPath = %v, %v, want found %v on %v", path, ok, want, g.Edges())
+		}
+		if ok {
+			checkHamiltonian(t, g, path, false)
+		}
+
+		cycle, ok := HamiltonianCycle(g)
+		if want := bruteHamiltonian(g, true); ok != want {
+			t.Fatalf("HamiltonianCycle = %v, %v, want found %v on %v", cycle, ok, want, g.Edges())
+		}
+		if ok {
+			checkHamiltonian(t, g, cycle, true)
+		}
+		trial++
+	}
+}
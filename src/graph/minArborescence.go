@@ -0,0 +1,152 @@
+package graph
+
+import "errors"
+
+var (
+	ErrUnreachable = errors.New("graph: vertex unreachable from root")
+	ErrNoVertex    = errors.New("graph: vertex not in graph")
+)
+
+type indexedEdge struct {
+	from, to int
+	weight   int
+}
+
+// chuLiu returns, as indices into edges, a minimum arborescence over vertices
+// 0..n-1 rooted at root, or false if some vertex cannot be reached.
+func chuLiu(n, root int, edges []indexedEdge) ([]int, bool) {
+	in := make([]int, n)
+	i := 0
+	for i < n {
+		in[i] = -1
+		i++
+	}
+
+	for k, e := range edges {
+		if e.to != root && e.from != e.to && (in[e.to] == -1 || e.weight < edges[in[e.to]].weight) {
+			in[e.to] = k
+		}
+	}
+
+	component := make([]int, n)
+	mark := make([]int, n)
+	i = 0
+	for i < n {
+		if i != root && in[i] == -1 {
+			return nil, false
+		}
+		component[i] = -1
+		mark[i] = -1
+		i++
+	}
+
+	cycles := 0
+	v := 0
+	for v < n {
+		x := v
+		for x != root && mark[x] == -1 && component[x] == -1 {
+			mark[x] = v
+			x = edges[in[x]].from
+		}
+
+		if x != root && mark[x] == v && component[x] == -1 {
+			for component[x] == -1 {
+				component[x] = cycles
+				x = edges[in[x]].from
+			}
+			cycles++
+		}
+		v++
+	}
+
+	if cycles == 0 {
+		chosen := make([]int, 0, n-1)
+		for v, k := range in {
+			if v != root {
+				chosen = append(chosen, k)
+			}
+		}
+		return chosen, true
+	}
+
+	next := cycles
+	for v := range component {
+		if component[v] == -1 {
+			component[v] = next
+			next++
+		}
+	}
+
+	var contracted []indexedEdge
+	var origin []int
+	for k, e := range edges {
+		cu, cv := component[e.from], component[e.to]
+		if cu == cv || e.to == root {
+			continue
+		}
+
+		w := e.weight
+		if cv < cycles {
+			w -= edges[in[e.to]].weight
+		}
+		contracted = append(contracted, indexedEdge{from: cu, to: cv, weight: w})
+		origin = append(origin, k)
+	}
+
+	sub, ok := chuLiu(next, component[root], contracted)
+	if !ok {
+		return nil, false
+	}
+
+	chosen := make([]int, 0, n-1)
+	entered := make([]int, cycles)
+	for _, k := range sub {
+		original := origin[k]
+		chosen = append(chosen, original)
+		if c := component[edges[original].to]; c < cycles {
+			entered[c] = edges[original].to
+		}
+	}
+
+	for v, c := range component {
+		if c < cycles && v != entered[c] {
+			chosen = append(chosen, in[v])
+		}
+	}
+
+	return chosen, true
+}
+
+// MinArborescence finds a minimum-weight spanning arborescence of a directed
+// graph rooted at root with the Chu-Liu/Edmonds algorithm: each vertex takes
+// its cheapest incoming edge, cycles among those are contracted and solved
+// recursively, and the cycles are then expanded again.
+func MinArborescence(g *Graph, root Vertex) ([]Edge, int, error) {
+	if !g.HasVertex(root) {
+		return nil, 0, ErrNoVertex
+	}
+
+	index := map[Vertex]int{}
+	for i, v := range g.vertices {
+		index[v] = i
+	}
+
+	edges := make([]indexedEdge, len(g.edges))
+	for k, e := range g.edges {
+		edges[k] = indexedEdge{from: index[e.From], to: index[e.To], weight: e.Weight}
+	}
+
+	chosen, ok := chuLiu(len(g.vertices), index[root], edges)
+	if !ok {
+		return nil, 0, ErrUnreachable
+	}
+
+	result := make([]Edge, len(chosen))
+	total := 0
+	for i, k := range chosen {
+		result[i] = g.edges[k]
+		total += g.edges[k].Weight
+	}
+
+	return result, total, nil
+}
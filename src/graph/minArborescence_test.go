@@ -0,0 +1,162 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// bruteArborescence tries every choice of one incoming edge per non-root
+// vertex and keeps the cheapest choice in which every vertex leads back to the
+// root.
+func bruteArborescence(g *Graph, root Vertex) (int, bool) {
+	vertices := g.Vertices()
+	incoming := map[Vertex][]Edge{}
+	for _, e := range g.Edges() {
+		if e.To != root && e.From != e.To {
+			incoming[e.To] = append(incoming[e.To], e)
+		}
+	}
+
+	var others []Vertex
+	for _, v := range vertices {
+		if v != root {
+			others = append(others, v)
+		}
+	}
+
+	best, found := math.MaxInt, false
+	parent := map[Vertex]Vertex{}
+
+	var choose func(i, weight int)
+	choose = func(i, weight int) {
+		if i == len(others) {
+			for _, v := range others {
+				steps, u := 0, v
+				for u != root && steps <= len(vertices) {
+					u = parent[u]
+					steps++
+				}
+				if u != root {
+					return
+				}
+			}
+			if weight < best {
+				best, found = weight, true
+			}
+			return
+		}
+
+		for _, e := range incoming[others[i]] {
+			parent[others[i]] = e.From
+			choose(i+1, weight+e.Weight)
+		}
+	}
+	choose(0, 0)
+
+	return best, found
+}
+
+func checkArborescence(t *testing.T, g *Graph, root Vertex, edges []Edge, total int) {
+	t.Helper()
+
+	if len(edges) != g.Order()-1 {
+		t.Fatalf("arborescence has %d edges for %d vertices", len(edges), g.Order())
+	}
+
+	parent := map[Vertex]Vertex{}
+	sum := 0
+	for _, e := range edges {
+		if e.To == root {
+			t.Fatalf("edge %v enters the root", e)
+		}
+		if _, ok := parent[e.To]; ok {
+			t.Fatalf("vertex %d has two incoming edges", e.To)
+		}
+		parent[e.To] = e.From
+		sum += e.Weight
+	}
+	if sum != total {
+		t.Errorf("edges weigh %d, reported total %d", sum, total)
+	}
+
+	for _, v := range g.Vertices() {
+		steps, u := 0, v
+		for u != root && steps <= g.Order() {
+			u = parent[u]
+			steps++
+		}
+		if u != root {
+			t.Errorf("vertex %d does not lead back to the root", v)
+		}
+	}
+}
+
+func TestMinArborescenceKnown(t *testing.T) {
+	// The cheapest incoming edges of 2 and 3 form a cycle that has to be
+	// broken by entering it from the root through 1 → 2.
+	g := NewGraph(true)
+	g.AddEdge(0, 1, 2)
+	g.AddEdge(0, 2, 10)
+	g.AddEdge(0, 3, 10)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 2, 1)
+	g.AddEdge(3, 1, 8)
+
+	edges, total, err := MinArborescence(g, 0)
+	if err != nil {
+		t.Fatalf("MinArborescence: %v", err)
+	}
+	if total != 4 {
+		t.Errorf("total = %d, want 4", total)
+	}
+	checkArborescence(t, g, 0, edges, total)
+}
+
+func TestMinArborescenceRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(246))
+
+	trial := 0
+	for trial < 300 {
+		n := 1 + r.Intn(5)
+		g := NewGraph(true)
+		i := 0
+		for i < n {
+			g.AddVertex(Vertex(i))
+			i++
+		}
+		count := r.Intn(n * n)
+		for count > 0 {
+			g.AddEdge(Vertex(r.Intn(n)), Vertex(r.Intn(n)), r.Intn(20)-5)
+			count--
+		}
+
+		want, ok := bruteArborescence(g, 0)
+		edges, total, err := MinArborescence(g, 0)
+		if !ok {
+			if err != ErrUnreachable {
+				t.Fatalf("edges %v: err = %v, want ErrUnreachable", g.Edges(), err)
+			}
+		} else {
+			if err != nil || total != want {
+				t.Fatalf("edges %v: MinArborescence = %d, %v, want %d", g.Edges(), total, err, want)
+			}
+			checkArborescence(t, g, 0, edges, total)
+		}
+		trial++
+	}
+}
+
+func TestMinArborescenceErrors(t *testing.T) {
+	g := NewGraph(true)
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(2, 3, 1)
+
+	if _, _, err := MinArborescence(g, 0); err != ErrUnreachable {
+		t.Errorf("disconnected graph: err = %v, want ErrUnreachable", err)
+	}
+	if _, _, err := MinArborescence(g, 9); err != ErrNoVertex {
+		t.Errorf("missing root: err = %v, want ErrNoVertex", err)
+	}
+}
@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrNegativeCycle = errors.New("graph: network contains a negative-cost cycle")
+
+type arc struct {
+	to       int
+	capacity int
+	cost     int
+	reverse  int
+}
+
+// FlowNetwork is a directed graph whose edges carry a capacity and a per-unit
+// cost, stored together with their residual counterparts.
+type FlowNetwork struct {
+	index map[Vertex]int
+	arcs  [][]arc
+}
+
+func NewFlowNetwork() *FlowNetwork {
+	return &FlowNetwork{index: map[Vertex]int{}}
+}
+
+func (n *FlowNetwork) vertex(v Vertex) int {
+	i, ok := n.index[v]
+	if !ok {
+		i = len(n.arcs)
+		n.index[v] = i
+		n.arcs = append(n.arcs, nil)
+	}
+	return i
+}
+
+func (n *FlowNetwork) AddEdge(from, to Vertex, capacity, cost int) {
+	u, v := n.vertex(from), n.vertex(to)
+
+	n.arcs[u] = append(n.arcs[u], arc{to: v, capacity: capacity, cost: cost, reverse: len(n.arcs[v])})
+	n.arcs[v] = append(n.arcs[v], arc{to: u, capacity: 0, cost: -cost, reverse: len(n.arcs[u]) - 1})
+}
+
+// MinCostMaxFlow augments along cheapest residual paths, found with SPFA since
+// residual arcs carry negative costs, until the sink is unreachable. The
+// network's capacities are consumed in the process. Edge costs may be
+// negative, but no cycle of positive-capacity edges may have a negative total
+// cost: cheapest paths are then undefined, and ErrNegativeCycle is returned
+// once SPFA dequeues some vertex as many times as there are vertices.
+func MinCostMaxFlow(g *FlowNetwork, source, sink Vertex) (flow, cost int, err error) {
+	s, okS := g.index[source]
+	t, okT := g.index[sink]
+	if !okS || !okT || s == t {
+		return 0, 0, nil
+	}
+
+	n := len(g.arcs)
+
+	for {
+		dist := make([]int, n)
+		inQueue := make([]bool, n)
+		dequeued := make([]int, n)
+		prevVertex := make([]int, n)
+		prevArc := make([]int, n)
+
+		i := 0
+		for i < n {
+			dist[i] = math.MaxInt
+			prevVertex[i] = -1
+			i++
+		}
+
+		dist[s] = 0
+		queue := []int{s}
+		inQueue[s] = true
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			inQueue[u] = false
+
+			// Without a negative cycle every cheapest path has fewer than n
+			// edges. The FIFO queue settles paths of k edges by its k-th
+			// pass and dequeues a vertex at most once per pass.
+			dequeued[u]++
+			if dequeued[u] >= n {
+				return 0, 0, ErrNegativeCycle
+			}
+
+			for k, a := range g.arcs[u] {
+				if a.capacity > 0 && dist[u]+a.cost < dist[a.to] {
+					dist[a.to] = dist[u] + a.cost
+					prevVertex[a.to] = u
+					prevArc[a.to] = k
+					if !inQueue[a.to] {
+						inQueue[a.to] = true
+						queue = append(queue, a.to)
+					}
+				}
+			}
+		}
+
+		if dist[t] == math.MaxInt {
+			return flow, cost, nil
+		}
+
+		push := math.MaxInt
+		v := t
+		for v != s {
+			a := g.arcs[prevVertex[v]][prevArc[v]]
+			if a.capacity < push {
+				push = a.capacity
+			}
+			v = prevVertex[v]
+		}
+
+		v = t
+		for v != s {
+			a := &g.arcs[prevVertex[v]][prevArc[v]]
+			a.capacity -= push
+			g.arcs[v][a.reverse].capacity += push
+			v = prevVertex[v]
+		}
+
+		flow += push
+		cost += push * dist[t]
+	}
+}
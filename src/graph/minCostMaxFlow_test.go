@@ -0,0 +1,145 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+type flowEdge struct {
+	from, to       Vertex
+	capacity, cost int
+}
+
+func buildNetwork(edges []flowEdge) *FlowNetwork {
+	n := NewFlowNetwork()
+	for _, e := range edges {
+		n.AddEdge(e.from, e.to, e.capacity, e.cost)
+	}
+	return n
+}
+
+// bruteMinCostMaxFlow tries every integral flow on every edge, keeps those
+// that conserve flow at inner vertices, and returns the largest flow value out
+// of source with the cheapest cost among them.
+func bruteMinCostMaxFlow(edges []flowEdge, vertices int, source, sink Vertex) (int, int) {
+	bestFlow, bestCost := 0, 0
+	assignment := make([]int, len(edges))
+
+	var search func(i int)
+	search = func(i int) {
+		if i == len(edges) {
+			balance := make([]int, vertices)
+			cost := 0
+			for k, e := range edges {
+				balance[e.from] -= assignment[k]
+				balance[e.to] += assignment[k]
+				cost += assignment[k] * e.cost
+			}
+			v := 0
+			for v < vertices {
+				if Vertex(v) != source && Vertex(v) != sink && balance[v] != 0 {
+					return
+				}
+				v++
+			}
+			flow := balance[sink]
+			if flow > bestFlow || (flow == bestFlow && cost < bestCost) {
+				bestFlow, bestCost = flow, cost
+			}
+			return
+		}
+
+		f := 0
+		for f <= edges[i].capacity {
+			assignment[i] = f
+			search(i + 1)
+			f++
+		}
+	}
+	search(0)
+
+	return bestFlow, bestCost
+}
+
+func TestMinCostMaxFlowKnown(t *testing.T) {
+	// Two units go s→a→t at cost 2 each and two more s→b→t at cost 5 each;
+	// the free a→b shortcut cannot raise the flow past the sink's capacity.
+	edges := []flowEdge{
+		{0, 1, 2, 1},
+		{1, 3, 2, 1},
+		{0, 2, 2, 3},
+		{2, 3, 2, 2},
+		{1, 2, 1, 0},
+	}
+	flow, cost, err := MinCostMaxFlow(buildNetwork(edges), 0, 3)
+	if flow != 4 || cost != 14 || err != nil {
+		t.Errorf("MinCostMaxFlow = (%d, %d, %v), want (4, 14, nil)", flow, cost, err)
+	}
+	if bf, bc := bruteMinCostMaxFlow(edges, 4, 0, 3); bf != flow || bc != cost {
+		t.Errorf("brute force = (%d, %d), MinCostMaxFlow = (%d, %d)", bf, bc, flow, cost)
+	}
+}
+
+func TestMinCostMaxFlowRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(219))
+
+	trial := 0
+	for trial < 200 {
+		vertices := 3 + r.Intn(3)
+		count := 3 + r.Intn(4)
+		edges := make([]flowEdge, count)
+		for i := range edges {
+			from := Vertex(r.Intn(vertices))
+			to := Vertex(r.Intn(vertices - 1))
+			if to >= from {
+				to++
+			}
+			edges[i] = flowEdge{from, to, r.Intn(4), r.Intn(6)}
+		}
+
+		sink := Vertex(vertices - 1)
+		network := buildNetwork(edges)
+		network.vertex(0)
+		network.vertex(sink)
+
+		flow, cost, err := MinCostMaxFlow(network, 0, sink)
+		wantFlow, wantCost := bruteMinCostMaxFlow(edges, vertices, 0, sink)
+		if flow != wantFlow || cost != wantCost || err != nil {
+			t.Fatalf("edges %v: MinCostMaxFlow = (%d, %d, %v), want (%d, %d, nil)", edges, flow, cost, err, wantFlow, wantCost)
+		}
+		trial++
+	}
+}
+
+func TestMinCostMaxFlowDegenerate(t *testing.T) {
+	network := buildNetwork([]flowEdge{{0, 1, 5, 1}})
+	if flow, cost, err := MinCostMaxFlow(network, 1, 0); flow != 0 || cost != 0 || err != nil {
+		t.Errorf("against the only edge = (%d, %d, %v), want (0, 0, nil)", flow, cost, err)
+	}
+	if flow, cost, err := MinCostMaxFlow(network, 0, 7); flow != 0 || cost != 0 || err != nil {
+		t.Errorf("unknown sink = (%d, %d, %v), want (0, 0, nil)", flow, cost, err)
+	}
+	if flow, cost, err := MinCostMaxFlow(network, 0, 0); flow != 0 || cost != 0 || err != nil {
+		t.Errorf("source == sink = (%d, %d, %v), want (0, 0, nil)", flow, cost, err)
+	}
+}
+
+func TestMinCostMaxFlowNegativeCycle(t *testing.T) {
+	// 1 → 2 → 1 costs -5 + 1 per lap, so cheapest paths to the sink are
+	// unbounded below.
+	network := buildNetwork([]flowEdge{
+		{0, 1, 1, 1},
+		{1, 2, 1, -5},
+		{2, 1, 1, 1},
+		{2, 3, 1, 1},
+	})
+	if flow, cost, err := MinCostMaxFlow(network, 0, 3); err != ErrNegativeCycle {
+		t.Errorf("MinCostMaxFlow = (%d, %d, %v), want ErrNegativeCycle", flow, cost, err)
+	}
+
+	// A negative edge on its own is fine.
+	network = buildNetwork([]flowEdge{{0, 1, 2, -3}, {1, 2, 2, 1}})
+	if flow, cost, err := MinCostMaxFlow(network, 0, 2); flow != 2 || cost != -4 || err != nil {
+		t.Errorf("MinCostMaxFlow with a negative edge = (%d, %d, %v), want (2, -4, nil)", flow, cost, err)
+	}
+}
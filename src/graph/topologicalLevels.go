@@ -0,0 +1,16 @@
+package graph
+
+// TopologicalLevels layers a DAG for drawing: sources sit on level 0 and every
+// other vertex one level below its deepest predecessor, i.e. at the length of
+// the longest path reaching it.
+func TopologicalLevels(g *Graph) (map[Vertex]int, error) {
+	return ComputeInOrder(g, func(vertex Vertex, depResults []int) int {
+		level := 0
+		for _, l := range depResults {
+			if l+1 > level {
+				level = l + 1
+			}
+		}
+		return level
+	})
+}
@@ -0,0 +1,40 @@
+package graph
+
+import "testing"
+
+func TestTopologicalLevelsDiamond(t *testing.T) {
+	// 1 → 2 → 3 → 4 plus a shortcut 1 → 4: the join sits below the longer
+	// branch, and the diamond 1 → {5, 6} → 7 joins at level 2.
+	g := NewGraph(true)
+	g.AddEdge(1, 2, 0)
+	g.AddEdge(2, 3, 0)
+	g.AddEdge(3, 4, 0)
+	g.AddEdge(1, 4, 0)
+	g.AddEdge(1, 5, 0)
+	g.AddEdge(1, 6, 0)
+	g.AddEdge(5, 7, 0)
+	g.AddEdge(6, 7, 0)
+	g.AddVertex(8)
+
+	levels, err := TopologicalLevels(g)
+	if err != nil {
+		t.Fatalf("TopologicalLevels: %v", err)
+	}
+
+	want := map[Vertex]int{1: 0, 2: 1, 3: 2, 4: 3, 5: 1, 6: 1, 7: 2, 8: 0}
+	for v, w := range want {
+		if levels[v] != w {
+			t.Errorf("level[%d] = %d, want %d", v, levels[v], w)
+		}
+	}
+}
+
+func TestTopologicalLevelsCycle(t *testing.T) {
+	g := NewGraph(true)
+	g.AddEdge(1, 2, 0)
+	g.AddEdge(2, 1, 0)
+
+	if levels, err := TopologicalLevels(g); err != ErrCycle || levels != nil {
+		t.Errorf("TopologicalLevels(cycle) = %v, %v, want nil, ErrCycle", levels, err)
+	}
+}
@@ -0,0 +1,43 @@
+package graph
+
+import "errors"
+
+var ErrCycle = errors.New("graph: graph contains a cycle")
+
+// TopologicalSort orders the vertices of a directed graph with Kahn's
+// algorithm so that every edge points forward, or returns ErrCycle.
+func TopologicalSort(g *Graph) ([]Vertex, error) {
+	inDegree := map[Vertex]int{}
+	for _, v := range g.vertices {
+		for _, e := range g.adjacency[v] {
+			inDegree[e.To]++
+		}
+	}
+
+	var queue []Vertex
+	for _, v := range g.vertices {
+		if inDegree[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+
+	order := make([]Vertex, 0, len(g.vertices))
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		order = append(order, v)
+
+		for _, e := range g.adjacency[v] {
+			inDegree[e.To]--
+			if inDegree[e.To] == 0 {
+				queue = append(queue, e.To)
+			}
+		}
+	}
+
+	if len(order) != len(g.vertices) {
+		return nil, ErrCycle
+	}
+
+	return order, nil
+}
@@ -0,0 +1,22 @@
+package graph
+
+// Transpose returns a new graph with every edge reversed and its weight kept.
+// The transpose of an undirected graph is the graph itself, so a copy is
+// returned in that case.
+func Transpose(g *Graph) *Graph {
+	t := NewGraph(g.directed)
+
+	for _, v := range g.vertices {
+		t.AddVertex(v)
+	}
+
+	for _, e := range g.edges {
+		if g.directed {
+			t.AddEdge(e.To, e.From, e.Weight)
+		} else {
+			t.AddEdge(e.From, e.To, e.Weight)
+		}
+	}
+
+	return t
+}
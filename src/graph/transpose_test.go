@@ -0,0 +1,71 @@
+package graph
+
+import "testing"
+
+func edgeSet(edges []Edge) map[Edge]int {
+	set := map[Edge]int{}
+	for _, e := range edges {
+		set[e]++
+	}
+	return set
+}
+
+func sameEdges(a, b []Edge) bool {
+	sa, sb := edgeSet(a), edgeSet(b)
+	if len(sa) != len(sb) {
+		return false
+	}
+	for e, n := range sa {
+		if sb[e] != n {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTransposeDirected(t *testing.T) {
+	g := NewGraph(true)
+	g.AddEdge(1, 2, 5)
+	g.AddEdge(2, 3, -1)
+	g.AddEdge(3, 1, 7)
+	g.AddEdge(1, 4, 2)
+	g.AddVertex(9)
+
+	tr := Transpose(g)
+	if !tr.Directed() {
+		t.Fatalf("Transpose of a directed graph is undirected")
+	}
+	if tr.Order() != g.Order() || !tr.HasVertex(9) {
+		t.Errorf("Transpose has %d vertices, want %d including 9", tr.Order(), g.Order())
+	}
+
+	reversed := edgeSet(tr.Edges())
+	for _, e := range g.Edges() {
+		if reversed[Edge{From: e.To, To: e.From, Weight: e.Weight}] == 0 {
+			t.Errorf("edge %v has no reversed counterpart in the transpose", e)
+		}
+	}
+	if len(tr.Edges()) != len(g.Edges()) {
+		t.Errorf("Transpose has %d edges, want %d", len(tr.Edges()), len(g.Edges()))
+	}
+
+	if twice := Transpose(tr); !sameEdges(twice.Edges(), g.Edges()) {
+		t.Errorf("double transpose edges = %v, want %v", twice.Edges(), g.Edges())
+	}
+}
+
+func TestTransposeUndirected(t *testing.T) {
+	g := NewGraph(false)
+	g.AddEdge(1, 2, 3)
+	g.AddEdge(2, 3, 4)
+
+	tr := Transpose(g)
+	if tr.Directed() || !sameEdges(tr.Edges(), g.Edges()) {
+		t.Errorf("undirected transpose edges = %v, want %v", tr.Edges(), g.Edges())
+	}
+
+	tr.AddEdge(3, 4, 1)
+	if g.HasVertex(4) {
+		t.Errorf("Transpose shares storage with the original graph")
+	}
+}
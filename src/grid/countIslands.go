@@ -0,0 +1,34 @@
+package grid
+
+import "unionfind"
+
+// CountIslands counts the 4-connected regions of 1-cells in a binary grid.
+// Every land cell starts as its own island, and each union of a cell with the
+// land below or to its right that joins two separate sets removes one.
+func CountIslands(g [][]int) int {
+	width := 0
+	for _, row := range g {
+		width = max(width, len(row))
+	}
+
+	sets := unionfind.NewUnionFind(len(g) * width)
+	islands := 0
+
+	for r, row := range g {
+		for c, cell := range row {
+			if cell != 1 {
+				continue
+			}
+			islands++
+
+			if inBounds(g, r+1, c) && g[r+1][c] == 1 && sets.Union(r*width+c, (r+1)*width+c) {
+				islands--
+			}
+			if inBounds(g, r, c+1) && g[r][c+1] == 1 && sets.Union(r*width+c, r*width+c+1) {
+				islands--
+			}
+		}
+	}
+
+	return islands
+}
@@ -0,0 +1,67 @@
+package grid
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// floodIslands counts islands by flood-filling each unvisited land cell.
+func floodIslands(g [][]int) int {
+	islands := 0
+	for r := range g {
+		for c := range g[r] {
+			if g[r][c] == 1 {
+				islands++
+				g = FloodFill(g, r, c, 2, FourConnected)
+			}
+		}
+	}
+	return islands
+}
+
+func TestCountIslands(t *testing.T) {
+	cases := []struct {
+		name string
+		g    [][]int
+		want int
+	}{
+		{"several", [][]int{
+			{1, 1, 0, 0, 0},
+			{1, 1, 0, 0, 1},
+			{0, 0, 1, 0, 1},
+			{0, 0, 0, 0, 0},
+			{1, 0, 1, 1, 1},
+		}, 5},
+		{"water", [][]int{{0, 0}, {0, 0}}, 0},
+		{"land", [][]int{{1, 1, 1}, {1, 1, 1}}, 1},
+		{"diagonal", [][]int{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}, 3},
+		{"U shape", [][]int{{1, 0, 1}, {1, 0, 1}, {1, 1, 1}}, 1},
+		{"empty", nil, 0},
+	}
+	for _, c := range cases {
+		if got := CountIslands(c.g); got != c.want {
+			t.Errorf("%s: CountIslands(%v) = %d, want %d", c.name, c.g, got, c.want)
+		}
+	}
+}
+
+func TestCountIslandsMatchesFloodFill(t *testing.T) {
+	r := rand.New(rand.NewSource(283))
+
+	trial := 0
+	for trial < 300 {
+		g := make([][]int, 1+r.Intn(10))
+		cols := 1 + r.Intn(10)
+		for i := range g {
+			g[i] = make([]int, cols)
+			for j := range g[i] {
+				g[i][j] = r.Intn(2)
+			}
+		}
+
+		if got, want := CountIslands(g), floodIslands(g); got != want {
+			t.Fatalf("CountIslands(%v) = %d, want %d", g, got, want)
+		}
+		trial++
+	}
+}
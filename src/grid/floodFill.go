@@ -0,0 +1,34 @@
+package grid
+
+// FloodFill returns a copy of g in which the region of cells connected to
+// (sr, sc) that share its color is painted newColor. An explicit stack keeps
+// large regions from exhausting the call stack. If (sr, sc) lies outside g the
+// copy is returned unchanged.
+func FloodFill(g [][]int, sr, sc, newColor int, connectivity Connectivity) [][]int {
+	out := clone(g)
+	if !inBounds(out, sr, sc) {
+		return out
+	}
+
+	original := out[sr][sc]
+	if original == newColor {
+		return out
+	}
+
+	out[sr][sc] = newColor
+	stack := [][2]int{{sr, sc}}
+	for len(stack) > 0 {
+		cell := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, d := range connectivity.offsets() {
+			r, c := cell[0]+d[0], cell[1]+d[1]
+			if inBounds(out, r, c) && out[r][c] == original {
+				out[r][c] = newColor
+				stack = append(stack, [2]int{r, c})
+			}
+		}
+	}
+
+	return out
+}
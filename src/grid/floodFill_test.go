@@ -0,0 +1,82 @@
+package grid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFloodFillRegion(t *testing.T) {
+	g := [][]int{
+		{1, 1, 1},
+		{1, 1, 0},
+		{1, 0, 1},
+	}
+	want := [][]int{
+		{2, 2, 2},
+		{2, 2, 0},
+		{2, 0, 1},
+	}
+
+	got := FloodFill(g, 1, 1, 2, FourConnected)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FloodFill = %v, want %v", got, want)
+	}
+	if g[0][0] != 1 {
+		t.Errorf("FloodFill modified its input: %v", g)
+	}
+}
+
+func TestFloodFillSameColor(t *testing.T) {
+	g := [][]int{{0, 0}, {0, 1}}
+	if got := FloodFill(g, 0, 0, 0, EightConnected); !reflect.DeepEqual(got, g) {
+		t.Errorf("FloodFill with the start color = %v, want %v unchanged", got, g)
+	}
+}
+
+func TestFloodFillConnectivity(t *testing.T) {
+	// The 1s on the diagonal only touch at corners.
+	g := [][]int{
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 1},
+	}
+
+	four := FloodFill(g, 0, 0, 5, FourConnected)
+	if want := [][]int{{5, 0, 0}, {0, 1, 0}, {0, 0, 1}}; !reflect.DeepEqual(four, want) {
+		t.Errorf("FloodFill(FourConnected) = %v, want %v", four, want)
+	}
+	eight := FloodFill(g, 0, 0, 5, EightConnected)
+	if want := [][]int{{5, 0, 0}, {0, 5, 0}, {0, 0, 5}}; !reflect.DeepEqual(eight, want) {
+		t.Errorf("FloodFill(EightConnected) = %v, want %v", eight, want)
+	}
+}
+
+func TestFloodFillOutOfBounds(t *testing.T) {
+	g := [][]int{{1, 1}, {1, 1}}
+	for _, start := range [][2]int{{-1, 0}, {0, -1}, {2, 0}, {0, 2}} {
+		if got := FloodFill(g, start[0], start[1], 3, FourConnected); !reflect.DeepEqual(got, g) {
+			t.Errorf("FloodFill from %v = %v, want %v unchanged", start, got, g)
+		}
+	}
+	if got := FloodFill(nil, 0, 0, 3, FourConnected); len(got) != 0 {
+		t.Errorf("FloodFill(nil) = %v, want empty", got)
+	}
+}
+
+func TestFloodFillLargeRegion(t *testing.T) {
+	// A region this large would be a deep recursion.
+	const size = 1000
+	g := make([][]int, size)
+	for i := range g {
+		g[i] = make([]int, size)
+	}
+
+	out := FloodFill(g, size/2, size/2, 1, FourConnected)
+	for _, row := range out {
+		for _, cell := range row {
+			if cell != 1 {
+				t.Fatalf("FloodFill left a cell unfilled")
+			}
+		}
+	}
+}
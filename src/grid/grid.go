@@ -0,0 +1,31 @@
+package grid
+
+type Connectivity int
+
+const (
+	FourConnected Connectivity = iota
+	EightConnected
+)
+
+var fourOffsets = [][2]int{{-1, 0}, {0, 1}, {1, 0}, {0, -1}}
+var eightOffsets = [][2]int{{-1, 0}, {-1, 1}, {0, 1}, {1, 1}, {1, 0}, {1, -1}, {0, -1}, {-1, -1}}
+
+func (c Connectivity) offsets() [][2]int {
+	if c == EightConnected {
+		return eightOffsets
+	}
+	return fourOffsets
+}
+
+func inBounds(g [][]int, r, c int) bool {
+	return r >= 0 && r < len(g) && c >= 0 && c < len(g[r])
+}
+
+func clone(g [][]int) [][]int {
+	out := make([][]int, len(g))
+	for i, row := range g {
+		out[i] = make([]int, len(row))
+		copy(out[i], row)
+	}
+	return out
+}
@@ -0,0 +1,181 @@
+package grid
+
+import (
+	"container/heap"
+	"math"
+)
+
+// OBSTACLE marks a cell that cannot be entered; any negative cost does.
+const OBSTACLE = -1
+
+type Cell struct {
+	Row, Col int
+}
+
+type cellItem struct {
+	cell     Cell
+	distance int
+}
+
+type cellHeap []cellItem
+
+func (h cellHeap) Len() int {
+	return len(h)
+}
+
+func (h cellHeap) Less(i, j int) bool {
+	return h[i].distance < h[j].distance
+}
+
+func (h cellHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *cellHeap) Push(x any) {
+	*h = append(*h, x.(cellItem))
+}
+
+func (h *cellHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+func open(costs [][]int, cell Cell) bool {
+	return inBounds(costs, cell.Row, cell.Col) && costs[cell.Row][cell.Col] >= 0
+}
+
+func newDistances(costs [][]int) [][]int {
+	distances := make([][]int, len(costs))
+	for i, row := range costs {
+		distances[i] = make([]int, len(row))
+		for j := range distances[i] {
+			distances[i][j] = math.MaxInt
+		}
+	}
+	return distances
+}
+
+func tracePath(previous map[Cell]Cell, start, goal Cell) []Cell {
+	path := []Cell{goal}
+	for path[len(path)-1] != start {
+		path = append(path, previous[path[len(path)-1]])
+	}
+
+	i, j := 0, len(path)-1
+	for i < j {
+		path[i], path[j] = path[j], path[i]
+		i++
+		j--
+	}
+	return path
+}
+
+// ShortestPath finds the cheapest 4-connected route from start to goal with
+// Dijkstra's algorithm, where moving into a cell costs costs[row][col]; the
+// start cell itself is free. It returns the total cost and the route including
+// both ends, or false if goal cannot be reached or either end is an obstacle.
+func ShortestPath(costs [][]int, start, goal Cell) (int, []Cell, bool) {
+	if !open(costs, start) || !open(costs, goal) {
+		return 0, nil, false
+	}
+
+	distances := newDistances(costs)
+	previous := map[Cell]Cell{}
+	distances[start.Row][start.Col] = 0
+	h := &cellHeap{{cell: start}}
+
+	for h.Len() > 0 {
+		item := heap.Pop(h).(cellItem)
+		cell := item.cell
+		if item.distance > distances[cell.Row][cell.Col] {
+			continue
+		}
+		if cell == goal {
+			return item.distance, tracePath(previous, start, goal), true
+		}
+
+		for _, d := range fourOffsets {
+			next := Cell{Row: cell.Row + d[0], Col: cell.Col + d[1]}
+			if !open(costs, next) {
+				continue
+			}
+
+			distance := item.distance + costs[next.Row][next.Col]
+			if distance < distances[next.Row][next.Col] {
+				distances[next.Row][next.Col] = distance
+				previous[next] = cell
+				heap.Push(h, cellItem{cell: next, distance: distance})
+			}
+		}
+	}
+
+	return 0, nil, false
+}
+
+// ZeroOneBFS is ShortestPath for grids whose open cells all cost 0 or 1, in
+// O(cells) instead of O(cells log cells): a deque keeps cells in distance
+// order by pushing zero-cost moves to the front and unit-cost ones to the
+// back. If any open cell costs anything else it returns false without
+// searching, wherever that cell lies.
+func ZeroOneBFS(costs [][]int, start, goal Cell) (int, []Cell, bool) {
+	if !open(costs, start) || !open(costs, goal) {
+		return 0, nil, false
+	}
+	for _, row := range costs {
+		for _, cost := range row {
+			if cost > 1 {
+				return 0, nil, false
+			}
+		}
+	}
+
+	distances := newDistances(costs)
+	previous := map[Cell]Cell{}
+	distances[start.Row][start.Col] = 0
+
+	// The deque is two slices: front is a stack of cells pushed to the front,
+	// and back a queue consumed from head.
+	var front []Cell
+	back := []Cell{start}
+	head := 0
+
+	for len(front) > 0 || head < len(back) {
+		var cell Cell
+		if len(front) > 0 {
+			cell = front[len(front)-1]
+			front = front[:len(front)-1]
+		} else {
+			cell = back[head]
+			head++
+		}
+
+		distance := distances[cell.Row][cell.Col]
+		if cell == goal {
+			return distance, tracePath(previous, start, goal), true
+		}
+
+		for _, d := range fourOffsets {
+			next := Cell{Row: cell.Row + d[0], Col: cell.Col + d[1]}
+			if !open(costs, next) {
+				continue
+			}
+
+			cost := costs[next.Row][next.Col]
+			if distance+cost >= distances[next.Row][next.Col] {
+				continue
+			}
+
+			distances[next.Row][next.Col] = distance + cost
+			previous[next] = cell
+			if cost == 0 {
+				front = append(front, next)
+			} else {
+				back = append(back, next)
+			}
+		}
+	}
+
+	return 0, nil, false
+}
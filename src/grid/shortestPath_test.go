@@ -0,0 +1,185 @@
+package grid
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// bellmanFord relaxes every cell until nothing changes, as a reference for
+// the cheapest cost into each cell.
+func bellmanFord(costs [][]int, start Cell) [][]int {
+	distances := newDistances(costs)
+	distances[start.Row][start.Col] = 0
+
+	changed := true
+	for changed {
+		changed = false
+		for r, row := range costs {
+			for c := range row {
+				if distances[r][c] == math.MaxInt {
+					continue
+				}
+				for _, d := range fourOffsets {
+					next := Cell{Row: r + d[0], Col: c + d[1]}
+					if !open(costs, next) {
+						continue
+					}
+					if distance := distances[r][c] + costs[next.Row][next.Col]; distance < distances[next.Row][next.Col] {
+						distances[next.Row][next.Col] = distance
+						changed = true
+					}
+				}
+			}
+		}
+	}
+	return distances
+}
+
+func checkPath(t *testing.T, costs [][]int, path []Cell, start, goal Cell, cost int) {
+	t.Helper()
+
+	if len(path) == 0 || path[0] != start || path[len(path)-1] != goal {
+		t.Fatalf("path %v does not run from %v to %v", path, start, goal)
+	}
+	total := 0
+	for i, cell := range path {
+		if !open(costs, cell) {
+			t.Fatalf("path %v enters blocked cell %v", path, cell)
+		}
+		if i == 0 {
+			continue
+		}
+		prev := path[i-1]
+		if abs(prev.Row-cell.Row)+abs(prev.Col-cell.Col) != 1 {
+			t.Fatalf("path %v jumps from %v to %v", path, prev, cell)
+		}
+		total += costs[cell.Row][cell.Col]
+	}
+	if total != cost {
+		t.Fatalf("path %v costs %d, want the reported %d", path, total, cost)
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func randomCosts(r *rand.Rand, maxCost int) [][]int {
+	costs := make([][]int, 1+r.Intn(8))
+	cols := 1 + r.Intn(8)
+	for i := range costs {
+		costs[i] = make([]int, cols)
+		for j := range costs[i] {
+			if r.Intn(5) == 0 {
+				costs[i][j] = OBSTACLE
+			} else {
+				costs[i][j] = r.Intn(maxCost + 1)
+			}
+		}
+	}
+	return costs
+}
+
+func TestShortestPathMatchesBellmanFord(t *testing.T) {
+	r := rand.New(rand.NewSource(284))
+
+	trial := 0
+	for trial < 300 {
+		costs := randomCosts(r, 9)
+		start := Cell{Row: r.Intn(len(costs)), Col: r.Intn(len(costs[0]))}
+		goal := Cell{Row: r.Intn(len(costs)), Col: r.Intn(len(costs[0]))}
+
+		cost, path, ok := ShortestPath(costs, start, goal)
+		want := math.MaxInt
+		if open(costs, start) && open(costs, goal) {
+			want = bellmanFord(costs, start)[goal.Row][goal.Col]
+		}
+		if ok != (want != math.MaxInt) || (ok && cost != want) {
+			t.Fatalf("ShortestPath(%v, %v, %v) = %d, %v, want %d", costs, start, goal, cost, ok, want)
+		}
+		if ok {
+			checkPath(t, costs, path, start, goal, cost)
+		}
+		trial++
+	}
+}
+
+func TestZeroOneBFSMatchesShortestPath(t *testing.T) {
+	r := rand.New(rand.NewSource(284))
+
+	trial := 0
+	for trial < 300 {
+		costs := randomCosts(r, 1)
+		start := Cell{Row: r.Intn(len(costs)), Col: r.Intn(len(costs[0]))}
+		goal := Cell{Row: r.Intn(len(costs)), Col: r.Intn(len(costs[0]))}
+
+		want, _, wantOK := ShortestPath(costs, start, goal)
+		cost, path, ok := ZeroOneBFS(costs, start, goal)
+		if ok != wantOK || cost != want {
+			t.Fatalf("ZeroOneBFS(%v, %v, %v) = %d, %v, want %d, %v", costs, start, goal, cost, ok, want, wantOK)
+		}
+		if ok {
+			checkPath(t, costs, path, start, goal, cost)
+		}
+		trial++
+	}
+}
+
+func TestShortestPathWall(t *testing.T) {
+	costs := [][]int{
+		{1, 1, OBSTACLE, 1},
+		{1, 1, OBSTACLE, 1},
+		{1, 1, OBSTACLE, 1},
+	}
+	start, goal := Cell{0, 0}, Cell{2, 3}
+
+	if cost, path, ok := ShortestPath(costs, start, goal); ok {
+		t.Errorf("ShortestPath through a wall = %d, %v, want unreachable", cost, path)
+	}
+	if cost, path, ok := ZeroOneBFS(costs, start, goal); ok {
+		t.Errorf("ZeroOneBFS through a wall = %d, %v, want unreachable", cost, path)
+	}
+	if _, _, ok := ShortestPath(costs, Cell{0, 2}, Cell{0, 0}); ok {
+		t.Errorf("ShortestPath from an obstacle succeeded")
+	}
+	if _, _, ok := ShortestPath(costs, start, Cell{5, 5}); ok {
+		t.Errorf("ShortestPath to an out-of-bounds goal succeeded")
+	}
+}
+
+func TestShortestPathDetour(t *testing.T) {
+	// Going straight across the expensive middle costs 9+1; the detour
+	// around it costs 1+1+1+1.
+	costs := [][]int{
+		{0, 9, 1},
+		{1, 1, 1},
+	}
+	cost, path, ok := ShortestPath(costs, Cell{0, 0}, Cell{0, 2})
+	if !ok || cost != 4 {
+		t.Fatalf("ShortestPath = %d, %v, want 4", cost, ok)
+	}
+	checkPath(t, costs, path, Cell{0, 0}, Cell{0, 2}, cost)
+
+	if cost, path, ok := ShortestPath(costs, Cell{1, 1}, Cell{1, 1}); !ok || cost != 0 || len(path) != 1 {
+		t.Errorf("ShortestPath to itself = %d, %v, %v, want 0, [start], true", cost, path, ok)
+	}
+}
+
+func TestZeroOneBFSRejectsLargeCost(t *testing.T) {
+	// The cost of 2 lies away from every route to the goal, and still has to
+	// be rejected.
+	costs := [][]int{
+		{0, 1, 0},
+		{OBSTACLE, OBSTACLE, OBSTACLE},
+		{0, 2, 0},
+	}
+	for _, goal := range []Cell{{0, 2}, {0, 0}} {
+		if cost, path, ok := ZeroOneBFS(costs, Cell{0, 0}, goal); ok || cost != 0 || path != nil {
+			t.Errorf("ZeroOneBFS to %v = %d, %v, %v, want 0, nil, false", goal, cost, path, ok)
+		}
+	}
+}
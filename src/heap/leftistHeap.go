@@ -0,0 +1,89 @@
+package heap
+
+type leftistNode[T any] struct {
+	value       T
+	rank        int
+	left, right *leftistNode[T]
+}
+
+func rank[T any](n *leftistNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.rank
+}
+
+// LeftistHeap is a min-heap ordered by less. Every node's left child has a
+// null-path length at least that of its right child, which keeps the right
+// spine, and therefore Merge, at O(log n).
+type LeftistHeap[T any] struct {
+	root *leftistNode[T]
+	size int
+	less func(a, b T) bool
+}
+
+func NewLeftistHeap[T any](less func(a, b T) bool) *LeftistHeap[T] {
+	return &LeftistHeap[T]{less: less}
+}
+
+func (h *LeftistHeap[T]) merge(a, b *leftistNode[T]) *leftistNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+
+	a.right = h.merge(a.right, b)
+	if rank(a.left) < rank(a.right) {
+		a.left, a.right = a.right, a.left
+	}
+	a.rank = rank(a.right) + 1
+
+	return a
+}
+
+// Merge moves every element of other into h, leaving other empty.
+func (h *LeftistHeap[T]) Merge(other *LeftistHeap[T]) {
+	if other == h {
+		return
+	}
+
+	h.root = h.merge(h.root, other.root)
+	h.size += other.size
+	other.root = nil
+	other.size = 0
+}
+
+func (h *LeftistHeap[T]) Push(value T) {
+	h.root = h.merge(h.root, &leftistNode[T]{value: value, rank: 1})
+	h.size++
+}
+
+func (h *LeftistHeap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+	return h.root.value, true
+}
+
+func (h *LeftistHeap[T]) Pop() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+
+	value := h.root.value
+	h.root = h.merge(h.root.left, h.root.right)
+	h.size--
+
+	return value, true
+}
+
+func (h *LeftistHeap[T]) Len() int {
+	return h.size
+}
@@ -0,0 +1,107 @@
+package heap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+// checkLeftist validates heap order and the null-path-length invariant below n
+// and returns the number of nodes it visited.
+func checkLeftist(t *testing.T, n *leftistNode[int]) int {
+	t.Helper()
+
+	if n == nil {
+		return 0
+	}
+	if rank(n.left) < rank(n.right) {
+		t.Errorf("node %d: left rank %d below right rank %d", n.value, rank(n.left), rank(n.right))
+	}
+	if n.rank != rank(n.right)+1 {
+		t.Errorf("node %d: rank %d, want %d", n.value, n.rank, rank(n.right)+1)
+	}
+	if n.left != nil && n.left.value < n.value || n.right != nil && n.right.value < n.value {
+		t.Errorf("node %d: child ordered before its parent", n.value)
+	}
+
+	return 1 + checkLeftist(t, n.left) + checkLeftist(t, n.right)
+}
+
+func TestLeftistHeapMerge(t *testing.T) {
+	r := rand.New(rand.NewSource(221))
+	a, b := NewLeftistHeap(intLess), NewLeftistHeap(intLess)
+
+	var all []int
+	i := 0
+	for i < 300 {
+		v := r.Intn(1000)
+		all = append(all, v)
+		if i < 120 {
+			a.Push(v)
+		} else {
+			b.Push(v)
+		}
+		i++
+	}
+
+	a.Merge(b)
+	if a.Len() != 300 || b.Len() != 0 {
+		t.Fatalf("after Merge: Len = %d and %d, want 300 and 0", a.Len(), b.Len())
+	}
+	if got := checkLeftist(t, a.root); got != 300 {
+		t.Errorf("merged heap has %d nodes, want 300", got)
+	}
+
+	sort.Ints(all)
+	for _, want := range all {
+		if got, ok := a.Pop(); !ok || got != want {
+			t.Fatalf("Pop = %d, %v, want %d", got, ok, want)
+		}
+	}
+	if _, ok := a.Pop(); ok {
+		t.Errorf("Pop on an empty heap succeeded")
+	}
+}
+
+func TestLeftistHeapInvariant(t *testing.T) {
+	r := rand.New(rand.NewSource(2210))
+	h := NewLeftistHeap(intLess)
+
+	i := 0
+	for i < 500 {
+		switch {
+		case r.Intn(3) == 0:
+			h.Pop()
+		case r.Intn(2) == 0:
+			other := NewLeftistHeap(intLess)
+			j := r.Intn(10)
+			for j > 0 {
+				other.Push(r.Intn(100))
+				j--
+			}
+			h.Merge(other)
+		default:
+			h.Push(r.Intn(100))
+		}
+
+		if got := checkLeftist(t, h.root); got != h.Len() {
+			t.Fatalf("heap has %d nodes, Len = %d", got, h.Len())
+		}
+		i++
+	}
+}
+
+func TestLeftistHeapEmpty(t *testing.T) {
+	h := NewLeftistHeap(intLess)
+	if _, ok := h.Peek(); ok {
+		t.Errorf("Peek on an empty heap succeeded")
+	}
+	h.Merge(h)
+	h.Push(3)
+	h.Merge(h)
+	if v, ok := h.Peek(); !ok || v != 3 || h.Len() != 1 {
+		t.Errorf("Peek after self-merge = %d, %v (Len %d), want 3", v, ok, h.Len())
+	}
+}
@@ -0,0 +1,150 @@
+package heap
+
+// PairingNode is the handle returned by PairingHeap.Push. It stays valid until
+// its value is popped.
+type PairingNode[T any] struct {
+	value   T
+	child   *PairingNode[T]
+	sibling *PairingNode[T]
+	prev    *PairingNode[T]
+}
+
+func (n *PairingNode[T]) Value() T {
+	return n.value
+}
+
+type PairingHeap[T any] struct {
+	root *PairingNode[T]
+	size int
+	less func(a, b T) bool
+}
+
+func NewPairingHeap[T any](less func(a, b T) bool) *PairingHeap[T] {
+	return &PairingHeap[T]{less: less}
+}
+
+func (h *PairingHeap[T]) link(a, b *PairingNode[T]) *PairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	a.sibling = nil
+	a.prev = nil
+
+	return a
+}
+
+func (h *PairingHeap[T]) Push(value T) *PairingNode[T] {
+	n := &PairingNode[T]{value: value}
+	h.root = h.link(h.root, n)
+	h.size++
+	return n
+}
+
+// Merge moves every element of other into h, leaving other empty. Handles
+// obtained from other remain valid and now belong to h.
+func (h *PairingHeap[T]) Merge(other *PairingHeap[T]) {
+	if other == h {
+		return
+	}
+
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+	other.root = nil
+	other.size = 0
+}
+
+func (h *PairingHeap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+	return h.root.value, true
+}
+
+// Children are linked in pairs from left to right, then the pairs are folded
+// together from right to left.
+func (h *PairingHeap[T]) mergePairs(first *PairingNode[T]) *PairingNode[T] {
+	var pairs []*PairingNode[T]
+
+	for first != nil {
+		a := first
+		b := a.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+		}
+
+		a.sibling, a.prev = nil, nil
+		if b != nil {
+			b.sibling, b.prev = nil, nil
+		}
+		pairs = append(pairs, h.link(a, b))
+	}
+
+	var result *PairingNode[T]
+	i := len(pairs) - 1
+	for i >= 0 {
+		result = h.link(pairs[i], result)
+		i--
+	}
+
+	return result
+}
+
+func (h *PairingHeap[T]) Pop() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+
+	n := h.root
+	h.root = h.mergePairs(n.child)
+	n.child = nil
+	h.size--
+
+	return n.value, true
+}
+
+// DecreaseKey lowers the value behind handle by cutting its subtree loose and
+// linking it back at the root. A newValue ordered after the current value is
+// ignored.
+func (h *PairingHeap[T]) DecreaseKey(handle *PairingNode[T], newValue T) {
+	if h.less(handle.value, newValue) {
+		return
+	}
+
+	handle.value = newValue
+	if handle == h.root {
+		return
+	}
+
+	if handle.prev.child == handle {
+		handle.prev.child = handle.sibling
+	} else {
+		handle.prev.sibling = handle.sibling
+	}
+	if handle.sibling != nil {
+		handle.sibling.prev = handle.prev
+	}
+	handle.sibling, handle.prev = nil, nil
+
+	h.root = h.link(h.root, handle)
+}
+
+func (h *PairingHeap[T]) Len() int {
+	return h.size
+}
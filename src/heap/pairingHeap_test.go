@@ -0,0 +1,189 @@
+package heap
+
+import (
+	stdheap "container/heap"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+type weightedEdge struct {
+	to, weight int
+}
+
+type distanceEntry struct {
+	vertex, distance int
+}
+
+type binaryDistanceHeap []distanceEntry
+
+func (h binaryDistanceHeap) Len() int           { return len(h) }
+func (h binaryDistanceHeap) Less(i, j int) bool { return h[i].distance < h[j].distance }
+func (h binaryDistanceHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *binaryDistanceHeap) Push(x any)        { *h = append(*h, x.(distanceEntry)) }
+func (h *binaryDistanceHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+func binaryHeapDijkstra(adj [][]weightedEdge, src int) []int {
+	dist := make([]int, len(adj))
+	for i := range dist {
+		dist[i] = math.MaxInt
+	}
+	dist[src] = 0
+
+	h := &binaryDistanceHeap{{vertex: src}}
+	for h.Len() > 0 {
+		item := stdheap.Pop(h).(distanceEntry)
+		if item.distance > dist[item.vertex] {
+			continue
+		}
+		for _, e := range adj[item.vertex] {
+			if d := item.distance + e.weight; d < dist[e.to] {
+				dist[e.to] = d
+				stdheap.Push(h, distanceEntry{vertex: e.to, distance: d})
+			}
+		}
+	}
+
+	return dist
+}
+
+// pairingHeapDijkstra keeps one handle per vertex and relaxes edges with
+// DecreaseKey instead of pushing duplicates.
+func pairingHeapDijkstra(adj [][]weightedEdge, src int) []int {
+	dist := make([]int, len(adj))
+	for i := range dist {
+		dist[i] = math.MaxInt
+	}
+	dist[src] = 0
+
+	h := NewPairingHeap(func(a, b distanceEntry) bool { return a.distance < b.distance })
+	handles := make([]*PairingNode[distanceEntry], len(adj))
+	handles[src] = h.Push(distanceEntry{vertex: src})
+	done := make([]bool, len(adj))
+
+	for h.Len() > 0 {
+		item, _ := h.Pop()
+		done[item.vertex] = true
+
+		for _, e := range adj[item.vertex] {
+			d := item.distance + e.weight
+			if done[e.to] || d >= dist[e.to] {
+				continue
+			}
+			dist[e.to] = d
+			if handles[e.to] == nil {
+				handles[e.to] = h.Push(distanceEntry{vertex: e.to, distance: d})
+			} else {
+				h.DecreaseKey(handles[e.to], distanceEntry{vertex: e.to, distance: d})
+			}
+		}
+	}
+
+	return dist
+}
+
+func TestPairingHeapDijkstra(t *testing.T) {
+	r := rand.New(rand.NewSource(222))
+
+	trial := 0
+	for trial < 50 {
+		n := 1 + r.Intn(60)
+		adj := make([][]weightedEdge, n)
+		edges := r.Intn(n * 4)
+		for edges > 0 {
+			u := r.Intn(n)
+			adj[u] = append(adj[u], weightedEdge{to: r.Intn(n), weight: r.Intn(20)})
+			edges--
+		}
+
+		want := binaryHeapDijkstra(adj, 0)
+		got := pairingHeapDijkstra(adj, 0)
+		for v := range want {
+			if got[v] != want[v] {
+				t.Fatalf("trial %d: distance to %d = %d, want %d", trial, v, got[v], want[v])
+			}
+		}
+		trial++
+	}
+}
+
+func TestPairingHeapDecreaseKey(t *testing.T) {
+	r := rand.New(rand.NewSource(2220))
+	h := NewPairingHeap(func(a, b distanceEntry) bool { return a.distance < b.distance })
+
+	handles := make([]*PairingNode[distanceEntry], 1000)
+	for i := range handles {
+		handles[i] = h.Push(distanceEntry{vertex: i, distance: 1_000_000 + r.Intn(1_000_000)})
+	}
+
+	// Lower random keys many times, sometimes to a value that is not lower at
+	// all, and interleave pops so handles sit at every depth.
+	popped := make([]bool, len(handles))
+	round := 0
+	for round < 5000 {
+		i := r.Intn(len(handles))
+		if !popped[i] {
+			current := handles[i].Value()
+			h.DecreaseKey(handles[i], distanceEntry{vertex: i, distance: current.distance - r.Intn(1000) + 100})
+		}
+		if round%50 == 0 {
+			min, _ := h.Peek()
+			got, _ := h.Pop()
+			if got != min {
+				t.Fatalf("Pop = %v, Peek said %v", got, min)
+			}
+			popped[got.vertex] = true
+		}
+		round++
+	}
+
+	remaining := 0
+	for i := range handles {
+		if !popped[i] {
+			remaining++
+		}
+	}
+	if h.Len() != remaining {
+		t.Fatalf("Len = %d, want %d", h.Len(), remaining)
+	}
+
+	prev := math.MinInt
+	for h.Len() > 0 {
+		v, _ := h.Pop()
+		if v.distance < prev {
+			t.Fatalf("Pop = %d after %d", v.distance, prev)
+		}
+		if v != handles[v.vertex].Value() {
+			t.Fatalf("Pop = %v, handle holds %v", v, handles[v.vertex].Value())
+		}
+		prev = v.distance
+	}
+}
+
+func TestPairingHeapMerge(t *testing.T) {
+	a, b := NewPairingHeap(intLess), NewPairingHeap(intLess)
+	a.Push(5)
+	a.Push(1)
+	handle := b.Push(9)
+	b.Push(3)
+
+	a.Merge(b)
+	a.DecreaseKey(handle, 0)
+	if b.Len() != 0 || a.Len() != 4 {
+		t.Fatalf("after Merge: Len = %d and %d, want 4 and 0", a.Len(), b.Len())
+	}
+
+	for _, want := range []int{0, 1, 3, 5} {
+		if got, ok := a.Pop(); !ok || got != want {
+			t.Fatalf("Pop = %d, %v, want %d", got, ok, want)
+		}
+	}
+	if _, ok := a.Pop(); ok {
+		t.Errorf("Pop on an empty heap succeeded")
+	}
+}
@@ -0,0 +1,77 @@
+package heap
+
+type skewNode[T any] struct {
+	value       T
+	left, right *skewNode[T]
+}
+
+// SkewHeap is a self-adjusting min-heap: Merge walks the right paths and swaps
+// children unconditionally, with no balance information stored, for amortized
+// O(log n) operations.
+type SkewHeap[T any] struct {
+	root *skewNode[T]
+	size int
+	less func(a, b T) bool
+}
+
+func NewSkewHeap[T any](less func(a, b T) bool) *SkewHeap[T] {
+	return &SkewHeap[T]{less: less}
+}
+
+func (h *SkewHeap[T]) merge(a, b *skewNode[T]) *skewNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+
+	a.left, a.right = h.merge(a.right, b), a.left
+
+	return a
+}
+
+// Merge moves every element of other into h, leaving other empty.
+func (h *SkewHeap[T]) Merge(other *SkewHeap[T]) {
+	if other == h {
+		return
+	}
+
+	h.root = h.merge(h.root, other.root)
+	h.size += other.size
+	other.root = nil
+	other.size = 0
+}
+
+func (h *SkewHeap[T]) Push(value T) {
+	h.root = h.merge(h.root, &skewNode[T]{value: value})
+	h.size++
+}
+
+func (h *SkewHeap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+	return h.root.value, true
+}
+
+func (h *SkewHeap[T]) Pop() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+
+	value := h.root.value
+	h.root = h.merge(h.root.left, h.root.right)
+	h.size--
+
+	return value, true
+}
+
+func (h *SkewHeap[T]) Len() int {
+	return h.size
+}
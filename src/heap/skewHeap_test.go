@@ -0,0 +1,143 @@
+package heap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestSkewHeapSortedExtraction(t *testing.T) {
+	r := rand.New(rand.NewSource(223))
+
+	trial := 0
+	for trial < 20 {
+		h := NewSkewHeap(intLess)
+		var all []int
+
+		i := 0
+		for i < 200 {
+			if r.Intn(4) == 0 {
+				other := NewSkewHeap(intLess)
+				j := r.Intn(20)
+				for j > 0 {
+					v := r.Intn(500)
+					other.Push(v)
+					all = append(all, v)
+					j--
+				}
+				h.Merge(other)
+				if other.Len() != 0 {
+					t.Fatalf("merged-away heap still has %d elements", other.Len())
+				}
+			} else {
+				v := r.Intn(500)
+				h.Push(v)
+				all = append(all, v)
+			}
+			i++
+		}
+
+		if h.Len() != len(all) {
+			t.Fatalf("Len = %d, want %d", h.Len(), len(all))
+		}
+
+		sort.Ints(all)
+		for _, want := range all {
+			if got, ok := h.Pop(); !ok || got != want {
+				t.Fatalf("Pop = %d, %v, want %d", got, ok, want)
+			}
+		}
+		if _, ok := h.Peek(); ok {
+			t.Errorf("Peek on a drained heap succeeded")
+		}
+		trial++
+	}
+}
+
+// Merge-heavy workload: build many small heaps, then meld them one by one
+// into the first and drain it.
+const (
+	benchmarkHeaps    = 1024
+	benchmarkHeapSize = 16
+)
+
+func benchmarkValues() [][]int {
+	r := rand.New(rand.NewSource(1))
+	values := make([][]int, benchmarkHeaps)
+	for i := range values {
+		values[i] = make([]int, benchmarkHeapSize)
+		for j := range values[i] {
+			values[i][j] = r.Int()
+		}
+	}
+	return values
+}
+
+func BenchmarkLeftistHeapMerge(b *testing.B) {
+	values := benchmarkValues()
+	b.ResetTimer()
+
+	n := 0
+	for n < b.N {
+		heaps := make([]*LeftistHeap[int], len(values))
+		for i, vs := range values {
+			heaps[i] = NewLeftistHeap(intLess)
+			for _, v := range vs {
+				heaps[i].Push(v)
+			}
+		}
+		for _, other := range heaps[1:] {
+			heaps[0].Merge(other)
+		}
+		for heaps[0].Len() > 0 {
+			heaps[0].Pop()
+		}
+		n++
+	}
+}
+
+func BenchmarkPairingHeapMerge(b *testing.B) {
+	values := benchmarkValues()
+	b.ResetTimer()
+
+	n := 0
+	for n < b.N {
+		heaps := make([]*PairingHeap[int], len(values))
+		for i, vs := range values {
+			heaps[i] = NewPairingHeap(intLess)
+			for _, v := range vs {
+				heaps[i].Push(v)
+			}
+		}
+		for _, other := range heaps[1:] {
+			heaps[0].Merge(other)
+		}
+		for heaps[0].Len() > 0 {
+			heaps[0].Pop()
+		}
+		n++
+	}
+}
+
+func BenchmarkSkewHeapMerge(b *testing.B) {
+	values := benchmarkValues()
+	b.ResetTimer()
+
+	n := 0
+	for n < b.N {
+		heaps := make([]*SkewHeap[int], len(values))
+		for i, vs := range values {
+			heaps[i] = NewSkewHeap(intLess)
+			for _, v := range vs {
+				heaps[i].Push(v)
+			}
+		}
+		for _, other := range heaps[1:] {
+			heaps[0].Merge(other)
+		}
+		for heaps[0].Len() > 0 {
+			heaps[0].Pop()
+		}
+		n++
+	}
+}
@@ -0,0 +1,242 @@
+package jsonvalid
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+const MAX_DEPTH = 10000
+
+type validator struct {
+	data []byte
+	pos  int
+}
+
+// Valid reports whether data is exactly one well-formed JSON value per RFC
+// 8259, optionally surrounded by whitespace, without decoding anything.
+// Strings must be valid UTF-8, and nesting deeper than MAX_DEPTH is rejected
+// so hostile input cannot exhaust the stack.
+func Valid(data []byte) bool {
+	v := &validator{data: data}
+	v.skipWhitespace()
+	if !v.value(0) {
+		return false
+	}
+	v.skipWhitespace()
+	return v.pos == len(v.data)
+}
+
+func (v *validator) peek() byte {
+	if v.pos >= len(v.data) {
+		return 0
+	}
+	return v.data[v.pos]
+}
+
+func (v *validator) skipWhitespace() {
+	for v.pos < len(v.data) {
+		switch v.data[v.pos] {
+		case ' ', '\t', '\n', '\r':
+			v.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (v *validator) value(depth int) bool {
+	switch c := v.peek(); {
+	case c == '{':
+		return v.object(depth + 1)
+	case c == '[':
+		return v.array(depth + 1)
+	case c == '"':
+		return v.quoted()
+	case c == '-' || isDigit(c):
+		return v.number()
+	case c == 't':
+		return v.literal("true")
+	case c == 'f':
+		return v.literal("false")
+	case c == 'n':
+		return v.literal("null")
+	}
+	return false
+}
+
+func (v *validator) literal(word string) bool {
+	if !bytes.HasPrefix(v.data[v.pos:], []byte(word)) {
+		return false
+	}
+	v.pos += len(word)
+	return true
+}
+
+func (v *validator) object(depth int) bool {
+	if depth > MAX_DEPTH {
+		return false
+	}
+	v.pos++
+	v.skipWhitespace()
+	if v.peek() == '}' {
+		v.pos++
+		return true
+	}
+
+	for {
+		if v.peek() != '"' || !v.quoted() {
+			return false
+		}
+		v.skipWhitespace()
+		if v.peek() != ':' {
+			return false
+		}
+		v.pos++
+		v.skipWhitespace()
+		if !v.value(depth) {
+			return false
+		}
+		v.skipWhitespace()
+
+		switch v.peek() {
+		case ',':
+			v.pos++
+			v.skipWhitespace()
+		case '}':
+			v.pos++
+			return true
+		default:
+			return false
+		}
+	}
+}
+
+func (v *validator) array(depth int) bool {
+	if depth > MAX_DEPTH {
+		return false
+	}
+	v.pos++
+	v.skipWhitespace()
+	if v.peek() == ']' {
+		v.pos++
+		return true
+	}
+
+	for {
+		if !v.value(depth) {
+			return false
+		}
+		v.skipWhitespace()
+
+		switch v.peek() {
+		case ',':
+			v.pos++
+			v.skipWhitespace()
+		case ']':
+			v.pos++
+			return true
+		default:
+			return false
+		}
+	}
+}
+
+func (v *validator) quoted() bool {
+	v.pos++
+	start := v.pos
+
+	for v.pos < len(v.data) {
+		c := v.data[v.pos]
+		switch {
+		case c == '"':
+			ok := utf8.Valid(v.data[start:v.pos])
+			v.pos++
+			return ok
+		case c < 0x20:
+			return false
+		case c == '\\':
+			v.pos++
+			if !v.escape() {
+				return false
+			}
+		default:
+			v.pos++
+		}
+	}
+
+	return false
+}
+
+// escape checks the escape sequence after a backslash at v.pos.
+func (v *validator) escape() bool {
+	switch v.peek() {
+	case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+		v.pos++
+		return true
+	case 'u':
+		v.pos++
+		i := 0
+		for i < 4 {
+			if !isHexDigit(v.peek()) {
+				return false
+			}
+			v.pos++
+			i++
+		}
+		return true
+	}
+	return false
+}
+
+// number follows the grammar -?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?,
+// which rules out leading zeros, a bare sign and a dangling point or exponent.
+func (v *validator) number() bool {
+	if v.peek() == '-' {
+		v.pos++
+	}
+
+	switch c := v.peek(); {
+	case c == '0':
+		v.pos++
+	case isDigit(c):
+		v.digits()
+	default:
+		return false
+	}
+
+	if v.peek() == '.' {
+		v.pos++
+		if !v.digits() {
+			return false
+		}
+	}
+
+	if c := v.peek(); c == 'e' || c == 'E' {
+		v.pos++
+		if c := v.peek(); c == '+' || c == '-' {
+			v.pos++
+		}
+		if !v.digits() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// digits consumes a run of digits and reports whether there was at least one.
+func (v *validator) digits() bool {
+	start := v.pos
+	for isDigit(v.peek()) {
+		v.pos++
+	}
+	return v.pos > start
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
@@ -0,0 +1,97 @@
+package jsonvalid
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	cases := []struct {
+		doc  string
+		want bool
+	}{
+		{`{}`, true},
+		{`[]`, true},
+		{` {"a": [1, 2, {"b": null}], "c": {"d": [true, false]}} `, true},
+		{`"esc\"aped \\ \/ \b\f\n\r\t é 😀"`, true},
+		{`[0, -0, 1.5, -12.25e+3, 6E-2, 1e10]`, true},
+		{`"héllo"`, true},
+		{"\t\n 42 \r\n", true},
+
+		{``, false},
+		{`   `, false},
+		{`[1, 2,]`, false},
+		{`{"a": 1,}`, false},
+		{`"unterminated`, false},
+		{`"bad \x escape"`, false},
+		{`"short \u12 escape"`, false},
+		{"\"raw \n newline\"", false},
+		{"\"\xff\"", false},
+		{`01`, false},
+		{`-`, false},
+		{`1.`, false},
+		{`.5`, false},
+		{`1e`, false},
+		{`+1`, false},
+		{`{} x`, false},
+		{`[1] [2]`, false},
+		{`{"a" 1}`, false},
+		{`{1: 2}`, false},
+		{`tru`, false},
+		{`nul`, false},
+		{`[1, 2`, false},
+	}
+	for _, c := range cases {
+		if got := Valid([]byte(c.doc)); got != c.want {
+			t.Errorf("Valid(%q) = %v, want %v", c.doc, got, c.want)
+		}
+	}
+}
+
+func TestValidDepthLimit(t *testing.T) {
+	ok := strings.Repeat("[", MAX_DEPTH) + strings.Repeat("]", MAX_DEPTH)
+	if !Valid([]byte(ok)) {
+		t.Errorf("Valid rejected nesting of exactly MAX_DEPTH")
+	}
+	deep := "[" + ok + "]"
+	if Valid([]byte(deep)) {
+		t.Errorf("Valid accepted nesting deeper than MAX_DEPTH")
+	}
+}
+
+// TestValidMatchesEncodingJSON mutates valid documents byte by byte and
+// checks that Valid agrees with the standard library on every result.
+func TestValidMatchesEncodingJSON(t *testing.T) {
+	r := rand.New(rand.NewSource(270))
+	seeds := []string{
+		`{"a": [1, -2.5e3, "x\ny", true, null], "b": {}}`,
+		`[0, [1, [2, [3]]], "A"]`,
+		`{"key": "value", "n": 0.001}`,
+	}
+	alphabet := []byte(`{}[],:"\ 0123456789.eE+-tfnulrsa`)
+
+	trial := 0
+	for trial < 5000 {
+		doc := []byte(seeds[r.Intn(len(seeds))])
+		edits := 1 + r.Intn(3)
+		for edits > 0 {
+			i := r.Intn(len(doc))
+			switch r.Intn(3) {
+			case 0:
+				doc[i] = alphabet[r.Intn(len(alphabet))]
+			case 1:
+				doc = append(doc[:i], doc[i+1:]...)
+			default:
+				doc = append(doc[:i], append([]byte{alphabet[r.Intn(len(alphabet))]}, doc[i:]...)...)
+			}
+			edits--
+		}
+
+		if got, want := Valid(doc), json.Valid(doc); got != want {
+			t.Fatalf("Valid(%q) = %v, encoding/json says %v", doc, got, want)
+		}
+		trial++
+	}
+}
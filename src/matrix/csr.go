@@ -0,0 +1,121 @@
+package matrix
+
+import (
+	"errors"
+	"sort"
+)
+
+var ErrOutOfBounds = errors.New("matrix: index out of bounds")
+
+type triple struct {
+	row, col int
+	value    float64
+}
+
+type CSRBuilder struct {
+	rows, cols int
+	entries    []triple
+}
+
+func NewCSRBuilder(rows, cols int) *CSRBuilder {
+	return &CSRBuilder{rows: rows, cols: cols}
+}
+
+// Add records a nonzero entry. Repeated (row, col) pairs are summed by Build.
+func (b *CSRBuilder) Add(row, col int, value float64) error {
+	if row < 0 || row >= b.rows || col < 0 || col >= b.cols {
+		return ErrOutOfBounds
+	}
+
+	b.entries = append(b.entries, triple{row, col, value})
+	return nil
+}
+
+func (b *CSRBuilder) Build() *CSR {
+	entries := make([]triple, len(b.entries))
+	copy(entries, b.entries)
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].row != entries[j].row {
+			return entries[i].row < entries[j].row
+		}
+		return entries[i].col < entries[j].col
+	})
+
+	m := &CSR{
+		rows:     b.rows,
+		cols:     b.cols,
+		rowStart: make([]int, b.rows+1),
+	}
+
+	prevRow, prevCol := -1, -1
+	for _, e := range entries {
+		if e.row == prevRow && e.col == prevCol {
+			m.values[len(m.values)-1] += e.value
+			continue
+		}
+
+		m.values = append(m.values, e.value)
+		m.colIndex = append(m.colIndex, e.col)
+		m.rowStart[e.row+1]++
+		prevRow, prevCol = e.row, e.col
+	}
+
+	i := 0
+	for i < b.rows {
+		m.rowStart[i+1] += m.rowStart[i]
+		i++
+	}
+
+	return m
+}
+
+type CSR struct {
+	rows, cols int
+	values     []float64
+	colIndex   []int
+	rowStart   []int
+}
+
+func (m *CSR) Dims() (int, int) {
+	return m.rows, m.cols
+}
+
+func (m *CSR) NonZeros() int {
+	return len(m.values)
+}
+
+func (m *CSR) Get(i, j int) float64 {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		panic(ErrOutOfBounds)
+	}
+
+	start, end := m.rowStart[i], m.rowStart[i+1]
+	k := start + sort.SearchInts(m.colIndex[start:end], j)
+	if k < end && m.colIndex[k] == j {
+		return m.values[k]
+	}
+
+	return 0
+}
+
+func (m *CSR) MultiplyVector(v []float64) []float64 {
+	if len(v) != m.cols {
+		panic(ErrDimensionMismatch)
+	}
+
+	out := make([]float64, m.rows)
+
+	i := 0
+	for i < m.rows {
+		sum := 0.0
+		k := m.rowStart[i]
+		for k < m.rowStart[i+1] {
+			sum += m.values[k] * v[m.colIndex[k]]
+			k++
+		}
+		out[i] = sum
+		i++
+	}
+
+	return out
+}
@@ -0,0 +1,73 @@
+package matrix
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestCSRMatchesDense(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for it := 0; it < 100; it++ {
+		rows, cols := 1+r.Intn(20), 1+r.Intn(20)
+		dense := New(rows, cols)
+		b := NewCSRBuilder(rows, cols)
+		for k := r.Intn(rows * cols); k > 0; k-- {
+			i, j, v := r.Intn(rows), r.Intn(cols), r.Float64()
+			dense[i][j] += v
+			if err := b.Add(i, j, v); err != nil {
+				t.Fatal(err)
+			}
+		}
+		m := b.Build()
+
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				if math.Abs(m.Get(i, j)-dense[i][j]) > 1e-12 {
+					t.Fatalf("Get(%d, %d) = %v, want %v", i, j, m.Get(i, j), dense[i][j])
+				}
+			}
+		}
+
+		v := make([]float64, cols)
+		for j := range v {
+			v[j] = r.Float64()
+		}
+		got := m.MultiplyVector(v)
+		for i := 0; i < rows; i++ {
+			want := 0.0
+			for j := 0; j < cols; j++ {
+				want += dense[i][j] * v[j]
+			}
+			if math.Abs(got[i]-want) > 1e-9 {
+				t.Fatalf("MultiplyVector row %d = %v, want %v", i, got[i], want)
+			}
+		}
+	}
+}
+
+func TestCSRStorageScalesWithNonZeros(t *testing.T) {
+	const n = 100000
+	b := NewCSRBuilder(n, n)
+	for i := 0; i < 50; i++ {
+		b.Add(i*1000, i*1999%n, 1)
+	}
+	m := b.Build()
+
+	if m.NonZeros() != 50 || len(m.values) != 50 || len(m.colIndex) != 50 {
+		t.Fatalf("stored %d values and %d column indices for 50 nonzeros", len(m.values), len(m.colIndex))
+	}
+	// Only the row offsets grow with a dimension, and linearly at that.
+	if len(m.rowStart) != n+1 {
+		t.Fatalf("len(rowStart) = %d, want %d", len(m.rowStart), n+1)
+	}
+}
+
+func TestCSRBuilderRejectsOutOfBounds(t *testing.T) {
+	b := NewCSRBuilder(2, 2)
+	for _, idx := range [][2]int{{-1, 0}, {0, 2}, {2, 0}} {
+		if err := b.Add(idx[0], idx[1], 1); err != ErrOutOfBounds {
+			t.Errorf("Add(%d, %d): err = %v, want ErrOutOfBounds", idx[0], idx[1], err)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package matrix
+
+import "math"
+
+// singularThreshold is the pivot magnitude at or below which a is treated as
+// singular: SINGULAR_EPSILON relative to a's largest entry, so that uniformly
+// scaling a matrix does not change whether it counts as singular.
+func singularThreshold(a [][]float64) float64 {
+	largest := 0.0
+	for _, row := range a {
+		for _, v := range row {
+			largest = max(largest, math.Abs(v))
+		}
+	}
+	return SINGULAR_EPSILON * largest
+}
+
+// Determinant factors a copy of a as PA = LU with partial pivoting; the
+// determinant is the product of U's diagonal, negated once per row swap. A
+// pivot no larger than SINGULAR_EPSILON times a's largest entry makes the
+// matrix singular and the determinant exactly 0.
+func Determinant(a [][]float64) (float64, error) {
+	rows, cols, err := dimensions(a)
+	if err != nil {
+		return 0, err
+	}
+	if rows != cols {
+		return 0, ErrNotSquare
+	}
+
+	lu := clone(a)
+	n := rows
+	det := 1.0
+	threshold := singularThreshold(a)
+
+	col := 0
+	for col < n {
+		pivot := col
+		i := col + 1
+		for i < n {
+			if math.Abs(lu[i][col]) > math.Abs(lu[pivot][col]) {
+				pivot = i
+			}
+			i++
+		}
+
+		if math.Abs(lu[pivot][col]) <= threshold {
+			return 0, nil
+		}
+
+		if pivot != col {
+			lu[pivot], lu[col] = lu[col], lu[pivot]
+			det = -det
+		}
+		det *= lu[col][col]
+
+		i = col + 1
+		for i < n {
+			factor := lu[i][col] / lu[col][col]
+			j := col
+			for j < n {
+				lu[i][j] -= factor * lu[col][j]
+				j++
+			}
+			i++
+		}
+
+		col++
+	}
+
+	return det, nil
+}
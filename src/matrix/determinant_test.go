@@ -0,0 +1,64 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDeterminant(t *testing.T) {
+	cases := []struct {
+		name string
+		a    [][]float64
+		want float64
+	}{
+		{"3x3", [][]float64{{2, -3, 1}, {2, 0, -1}, {1, 4, 5}}, 49},
+		{"needs pivoting", [][]float64{{0, 1}, {1, 0}}, -1},
+		{"identity", Identity(4), 1},
+		{"singular", [][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, 0},
+		{"empty", [][]float64{}, 1},
+	}
+
+	for _, c := range cases {
+		got, err := Determinant(c.a)
+		if err != nil || math.Abs(got-c.want) > 1e-9 {
+			t.Errorf("%s: Determinant = %v, %v, want %v", c.name, got, err, c.want)
+		}
+	}
+}
+
+func TestDeterminantNumericallySingular(t *testing.T) {
+	a := [][]float64{{1, 2}, {1, 2 + 1e-15}}
+	if got, _ := Determinant(a); got != 0 {
+		t.Fatalf("Determinant = %v, want exactly 0", got)
+	}
+}
+
+func TestDeterminantScaledIdentity(t *testing.T) {
+	for _, scale := range []float64{1e-7, 1e-50, 1e50} {
+		n := 2
+		for n <= 4 {
+			a := Identity(n)
+			i := 0
+			for i < n {
+				a[i][i] = scale
+				i++
+			}
+
+			want := math.Pow(scale, float64(n))
+			if got, err := Determinant(a); err != nil || math.Abs(got-want) > 1e-9*want {
+				t.Errorf("Determinant(%g·I of size %d) = %v, %v, want %v", scale, n, got, err, want)
+			}
+			n++
+		}
+	}
+
+	if got, _ := Determinant(New(3, 3)); got != 0 {
+		t.Errorf("Determinant(zero matrix) = %v, want 0", got)
+	}
+}
+
+func TestDeterminantNotSquare(t *testing.T) {
+	if _, err := Determinant(New(2, 3)); err != ErrNotSquare {
+		t.Fatalf("err = %v, want ErrNotSquare", err)
+	}
+}
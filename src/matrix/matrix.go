@@ -0,0 +1,119 @@
+package matrix
+
+import "errors"
+
+var (
+	ErrDimensionMismatch = errors.New("matrix: dimension mismatch")
+	ErrNotSquare         = errors.New("matrix: matrix is not square")
+)
+
+func dimensions(a [][]float64) (int, int, error) {
+	rows := len(a)
+	if rows == 0 {
+		return 0, 0, nil
+	}
+
+	cols := len(a[0])
+	for _, row := range a {
+		if len(row) != cols {
+			return 0, 0, ErrDimensionMismatch
+		}
+	}
+
+	return rows, cols, nil
+}
+
+func New(rows, cols int) [][]float64 {
+	m := make([][]float64, rows)
+	backing := make([]float64, rows*cols)
+
+	i := 0
+	for i < rows {
+		m[i] = backing[i*cols : (i+1)*cols : (i+1)*cols]
+		i++
+	}
+
+	return m
+}
+
+func Identity(n int) [][]float64 {
+	m := New(n, n)
+
+	i := 0
+	for i < n {
+		m[i][i] = 1
+		i++
+	}
+
+	return m
+}
+
+func clone(a [][]float64) [][]float64 {
+	rows := len(a)
+	cols := 0
+	if rows > 0 {
+		cols = len(a[0])
+	}
+
+	m := New(rows, cols)
+	for i, row := range a {
+		copy(m[i], row)
+	}
+
+	return m
+}
+
+func Transpose(a [][]float64) [][]float64 {
+	rows := len(a)
+	if rows == 0 {
+		return [][]float64{}
+	}
+	cols := len(a[0])
+
+	t := New(cols, rows)
+
+	i := 0
+	for i < rows {
+		j := 0
+		for j < cols {
+			t[j][i] = a[i][j]
+			j++
+		}
+		i++
+	}
+
+	return t
+}
+
+func Multiply(a, b [][]float64) ([][]float64, error) {
+	aRows, aCols, err := dimensions(a)
+	if err != nil {
+		return nil, err
+	}
+	bRows, bCols, err := dimensions(b)
+	if err != nil {
+		return nil, err
+	}
+	if aCols != bRows {
+		return nil, ErrDimensionMismatch
+	}
+
+	c := New(aRows, bCols)
+
+	i := 0
+	for i < aRows {
+		k := 0
+		for k < aCols {
+			factor := a[i][k]
+			j := 0
+			for j < bCols {
+				c[i][j] += factor * b[k][j]
+				j++
+			}
+			k++
+		}
+		i++
+	}
+
+	return c, nil
+}
@@ -0,0 +1,79 @@
+package matrix
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func randomMatrix(r *rand.Rand, rows, cols int) [][]float64 {
+	m := New(rows, cols)
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] = r.Float64()*2 - 1
+		}
+	}
+	return m
+}
+
+func approxEqual(a, b [][]float64, tolerance float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+		for j := range a[i] {
+			if math.Abs(a[i][j]-b[i][j]) > tolerance {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestMultiplyIdentity(t *testing.T) {
+	a := [][]float64{{1, 2, 3}, {4, 5, 6}}
+
+	got, err := Multiply(a, Identity(3))
+	if err != nil || !reflect.DeepEqual(got, a) {
+		t.Fatalf("a·I = %v, %v", got, err)
+	}
+	got, err = Multiply(Identity(2), a)
+	if err != nil || !reflect.DeepEqual(got, a) {
+		t.Fatalf("I·a = %v, %v", got, err)
+	}
+}
+
+func TestMultiplyKnown(t *testing.T) {
+	a := [][]float64{{1, 2}, {3, 4}}
+	b := [][]float64{{5, 6}, {7, 8}}
+	want := [][]float64{{19, 22}, {43, 50}}
+
+	if got, err := Multiply(a, b); err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("Multiply = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestMultiplyDimensionMismatch(t *testing.T) {
+	if _, err := Multiply(New(2, 3), New(2, 3)); err != ErrDimensionMismatch {
+		t.Errorf("2x3 · 2x3: err = %v, want ErrDimensionMismatch", err)
+	}
+	if _, err := Multiply([][]float64{{1, 2}, {3}}, New(2, 2)); err != ErrDimensionMismatch {
+		t.Errorf("ragged operand: err = %v, want ErrDimensionMismatch", err)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	a := [][]float64{{1, 2, 3}, {4, 5, 6}}
+	want := [][]float64{{1, 4}, {2, 5}, {3, 6}}
+
+	if got := Transpose(a); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Transpose = %v, want %v", got, want)
+	}
+	if got := Transpose(Transpose(a)); !reflect.DeepEqual(got, a) {
+		t.Fatalf("double Transpose = %v, want %v", got, a)
+	}
+}
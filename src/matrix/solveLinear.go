@@ -0,0 +1,75 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+)
+
+const SINGULAR_EPSILON = 1e-12
+
+var ErrSingular = errors.New("matrix: matrix is singular")
+
+func SolveLinear(a [][]float64, b []float64) ([]float64, error) {
+	rows, cols, err := dimensions(a)
+	if err != nil {
+		return nil, err
+	}
+	if rows != cols {
+		return nil, ErrNotSquare
+	}
+	if len(b) != rows {
+		return nil, ErrDimensionMismatch
+	}
+
+	n := rows
+	m := clone(a)
+	x := make([]float64, n)
+	copy(x, b)
+	threshold := singularThreshold(a)
+
+	col := 0
+	for col < n {
+		pivot := col
+		i := col + 1
+		for i < n {
+			if math.Abs(m[i][col]) > math.Abs(m[pivot][col]) {
+				pivot = i
+			}
+			i++
+		}
+
+		if math.Abs(m[pivot][col]) <= threshold {
+			return nil, ErrSingular
+		}
+
+		m[pivot], m[col] = m[col], m[pivot]
+		x[pivot], x[col] = x[col], x[pivot]
+
+		i = col + 1
+		for i < n {
+			factor := m[i][col] / m[col][col]
+			j := col
+			for j < n {
+				m[i][j] -= factor * m[col][j]
+				j++
+			}
+			x[i] -= factor * x[col]
+			i++
+		}
+
+		col++
+	}
+
+	i := n - 1
+	for i >= 0 {
+		j := i + 1
+		for j < n {
+			x[i] -= m[i][j] * x[j]
+			j++
+		}
+		x[i] /= m[i][i]
+		i--
+	}
+
+	return x, nil
+}
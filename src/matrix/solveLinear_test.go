@@ -0,0 +1,86 @@
+package matrix
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSolveLinearKnown(t *testing.T) {
+	a := [][]float64{{2, 1, -1}, {-3, -1, 2}, {-2, 1, 2}}
+	b := []float64{8, -11, -3}
+	want := []float64{2, 3, -1}
+
+	x, err := SolveLinear(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range want {
+		if math.Abs(x[i]-want[i]) > 1e-9 {
+			t.Fatalf("x = %v, want %v", x, want)
+		}
+	}
+}
+
+func TestSolveLinearSingular(t *testing.T) {
+	a := [][]float64{{1, 2}, {2, 4}}
+	if _, err := SolveLinear(a, []float64{1, 2}); err != ErrSingular {
+		t.Fatalf("err = %v, want ErrSingular", err)
+	}
+}
+
+func TestSolveLinearSmallScale(t *testing.T) {
+	a := [][]float64{{2e-7, 1e-7}, {1e-7, 3e-7}}
+	x, err := SolveLinear(a, []float64{5e-7, 5e-7})
+	if err != nil {
+		t.Fatalf("SolveLinear(small well-conditioned system): %v", err)
+	}
+	if math.Abs(x[0]-2) > 1e-9 || math.Abs(x[1]-1) > 1e-9 {
+		t.Errorf("x = %v, want [2 1]", x)
+	}
+
+	if _, err := SolveLinear(New(2, 2), []float64{0, 0}); err != ErrSingular {
+		t.Errorf("zero matrix: err = %v, want ErrSingular", err)
+	}
+}
+
+func TestSolveLinearErrors(t *testing.T) {
+	if _, err := SolveLinear(New(2, 3), []float64{1, 2}); err != ErrNotSquare {
+		t.Errorf("non-square: err = %v, want ErrNotSquare", err)
+	}
+	if _, err := SolveLinear(Identity(2), []float64{1}); err != ErrDimensionMismatch {
+		t.Errorf("short b: err = %v, want ErrDimensionMismatch", err)
+	}
+}
+
+func TestSolveLinearResidual(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for it := 0; it < 100; it++ {
+		n := 1 + r.Intn(10)
+		a := randomMatrix(r, n, n)
+		// Diagonal dominance keeps the random systems well conditioned.
+		for i := 0; i < n; i++ {
+			a[i][i] += float64(n)
+		}
+		b := make([]float64, n)
+		for i := range b {
+			b[i] = r.Float64()
+		}
+
+		x, err := SolveLinear(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		residual := 0.0
+		for i := 0; i < n; i++ {
+			sum := 0.0
+			for j := 0; j < n; j++ {
+				sum += a[i][j] * x[j]
+			}
+			residual += (sum - b[i]) * (sum - b[i])
+		}
+		if math.Sqrt(residual) > 1e-9 {
+			t.Fatalf("residual %v for n = %d", math.Sqrt(residual), n)
+		}
+	}
+}
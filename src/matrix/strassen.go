@@ -0,0 +1,131 @@
+package matrix
+
+const STRASSEN_THRESHOLD = 64
+
+// StrassenMultiply multiplies two n×n matrices with Strassen's seven-product
+// recursion. The operands are zero-padded to the next power of two, and
+// blocks at or below STRASSEN_THRESHOLD fall back to the naive product.
+func StrassenMultiply(a, b [][]float64) ([][]float64, error) {
+	aRows, aCols, err := dimensions(a)
+	if err != nil {
+		return nil, err
+	}
+	bRows, bCols, err := dimensions(b)
+	if err != nil {
+		return nil, err
+	}
+	if aRows != aCols || bRows != bCols {
+		return nil, ErrNotSquare
+	}
+	if aRows != bRows {
+		return nil, ErrDimensionMismatch
+	}
+
+	n := aRows
+	size := 1
+	for size < n {
+		size *= 2
+	}
+
+	c := strassen(pad(a, size), pad(b, size))
+
+	result := New(n, n)
+	i := 0
+	for i < n {
+		copy(result[i], c[i][:n])
+		i++
+	}
+
+	return result, nil
+}
+
+func pad(a [][]float64, size int) [][]float64 {
+	p := New(size, size)
+	for i, row := range a {
+		copy(p[i], row)
+	}
+	return p
+}
+
+func strassen(a, b [][]float64) [][]float64 {
+	n := len(a)
+	if n <= STRASSEN_THRESHOLD {
+		c, _ := Multiply(a, b)
+		return c
+	}
+
+	h := n / 2
+	a11, a12, a21, a22 := quadrants(a, h)
+	b11, b12, b21, b22 := quadrants(b, h)
+
+	m1 := strassen(add(a11, a22), add(b11, b22))
+	m2 := strassen(add(a21, a22), b11)
+	m3 := strassen(a11, sub(b12, b22))
+	m4 := strassen(a22, sub(b21, b11))
+	m5 := strassen(add(a11, a12), b22)
+	m6 := strassen(sub(a21, a11), add(b11, b12))
+	m7 := strassen(sub(a12, a22), add(b21, b22))
+
+	c := New(n, n)
+	i := 0
+	for i < h {
+		j := 0
+		for j < h {
+			c[i][j] = m1[i][j] + m4[i][j] - m5[i][j] + m7[i][j]
+			c[i][j+h] = m3[i][j] + m5[i][j]
+			c[i+h][j] = m2[i][j] + m4[i][j]
+			c[i+h][j+h] = m1[i][j] - m2[i][j] + m3[i][j] + m6[i][j]
+			j++
+		}
+		i++
+	}
+
+	return c
+}
+
+func quadrants(a [][]float64, h int) (a11, a12, a21, a22 [][]float64) {
+	a11, a12, a21, a22 = make([][]float64, h), make([][]float64, h), make([][]float64, h), make([][]float64, h)
+
+	i := 0
+	for i < h {
+		a11[i], a12[i] = a[i][:h:h], a[i][h:]
+		a21[i], a22[i] = a[i+h][:h:h], a[i+h][h:]
+		i++
+	}
+
+	return a11, a12, a21, a22
+}
+
+func add(a, b [][]float64) [][]float64 {
+	n := len(a)
+	c := New(n, n)
+
+	i := 0
+	for i < n {
+		j := 0
+		for j < n {
+			c[i][j] = a[i][j] + b[i][j]
+			j++
+		}
+		i++
+	}
+
+	return c
+}
+
+func sub(a, b [][]float64) [][]float64 {
+	n := len(a)
+	c := New(n, n)
+
+	i := 0
+	for i < n {
+		j := 0
+		for j < n {
+			c[i][j] = a[i][j] - b[i][j]
+			j++
+		}
+		i++
+	}
+
+	return c
+}
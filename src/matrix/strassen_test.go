@@ -0,0 +1,67 @@
+package matrix
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestStrassenMultiplyMatchesNaive(t *testing.T) {
+	r := rand.New(rand.NewSource(236))
+
+	// Sizes below, at and above the threshold, including ones that need
+	// padding to a power of two.
+	for _, n := range []int{1, 2, 7, 64, 65, 100, 128} {
+		a, b := randomMatrix(r, n, n), randomMatrix(r, n, n)
+
+		got, err := StrassenMultiply(a, b)
+		if err != nil {
+			t.Fatalf("StrassenMultiply(%dx%d): %v", n, n, err)
+		}
+		want, _ := Multiply(a, b)
+		if len(got) != n || !approxEqual(got, want, 1e-9) {
+			t.Errorf("StrassenMultiply differs from Multiply at n=%d", n)
+		}
+	}
+}
+
+func TestStrassenMultiplyErrors(t *testing.T) {
+	square := Identity(2)
+
+	if _, err := StrassenMultiply(New(2, 3), square); err != ErrNotSquare {
+		t.Errorf("non-square operand: err = %v, want ErrNotSquare", err)
+	}
+	if _, err := StrassenMultiply(square, Identity(3)); err != ErrDimensionMismatch {
+		t.Errorf("mismatched sizes: err = %v, want ErrDimensionMismatch", err)
+	}
+	if _, err := StrassenMultiply([][]float64{{1, 2}, {3}}, square); err == nil {
+		t.Errorf("ragged operand accepted")
+	}
+}
+
+func benchmarkMultiply(b *testing.B, n int, multiply func(a, b [][]float64) ([][]float64, error)) {
+	r := rand.New(rand.NewSource(1))
+	x, y := randomMatrix(r, n, n), randomMatrix(r, n, n)
+	b.ResetTimer()
+
+	i := 0
+	for i < b.N {
+		multiply(x, y)
+		i++
+	}
+}
+
+func BenchmarkMultiply512(b *testing.B) {
+	benchmarkMultiply(b, 512, Multiply)
+}
+
+func BenchmarkStrassenMultiply512(b *testing.B) {
+	benchmarkMultiply(b, 512, StrassenMultiply)
+}
+
+func BenchmarkMultiply1024(b *testing.B) {
+	benchmarkMultiply(b, 1024, Multiply)
+}
+
+func BenchmarkStrassenMultiply1024(b *testing.B) {
+	benchmarkMultiply(b, 1024, StrassenMultiply)
+}
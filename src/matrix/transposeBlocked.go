@@ -0,0 +1,45 @@
+package matrix
+
+const TRANSPOSE_BLOCK = 32
+
+// TransposeBlocked produces the same result as Transpose, but recursively
+// halves the longer dimension until a block is small enough to fit in cache,
+// which suits large matrices far better than a row-by-row walk.
+func TransposeBlocked(a [][]float64) [][]float64 {
+	rows := len(a)
+	if rows == 0 {
+		return [][]float64{}
+	}
+	cols := len(a[0])
+
+	t := New(cols, rows)
+	transposeRange(a, t, 0, rows, 0, cols)
+	return t
+}
+
+func transposeRange(a, t [][]float64, rowLo, rowHi, colLo, colHi int) {
+	rows, cols := rowHi-rowLo, colHi-colLo
+
+	if rows <= TRANSPOSE_BLOCK && cols <= TRANSPOSE_BLOCK {
+		i := rowLo
+		for i < rowHi {
+			j := colLo
+			for j < colHi {
+				t[j][i] = a[i][j]
+				j++
+			}
+			i++
+		}
+		return
+	}
+
+	if rows >= cols {
+		mid := rowLo + rows/2
+		transposeRange(a, t, rowLo, mid, colLo, colHi)
+		transposeRange(a, t, mid, rowHi, colLo, colHi)
+	} else {
+		mid := colLo + cols/2
+		transposeRange(a, t, rowLo, rowHi, colLo, mid)
+		transposeRange(a, t, rowLo, rowHi, mid, colHi)
+	}
+}
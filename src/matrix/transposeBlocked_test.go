@@ -0,0 +1,46 @@
+package matrix
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestTransposeBlockedMatchesNaive(t *testing.T) {
+	r := rand.New(rand.NewSource(235))
+
+	// Shapes straddle the block size so both the base case and uneven
+	// splits of the longer dimension are exercised.
+	shapes := [][2]int{{1, 1}, {1, 70}, {70, 1}, {3, 5}, {31, 33}, {32, 32}, {65, 129}, {200, 17}}
+	for _, shape := range shapes {
+		a := randomMatrix(r, shape[0], shape[1])
+		if got, want := TransposeBlocked(a), Transpose(a); !reflect.DeepEqual(got, want) {
+			t.Errorf("TransposeBlocked differs from Transpose on a %dx%d matrix", shape[0], shape[1])
+		}
+	}
+}
+
+func TestTransposeBlockedEmpty(t *testing.T) {
+	if got := TransposeBlocked([][]float64{}); len(got) != 0 {
+		t.Errorf("TransposeBlocked(empty) = %v, want empty", got)
+	}
+}
+
+func benchmarkTranspose(b *testing.B, transpose func([][]float64) [][]float64) {
+	a := randomMatrix(rand.New(rand.NewSource(1)), 2048, 2048)
+	b.ResetTimer()
+
+	n := 0
+	for n < b.N {
+		transpose(a)
+		n++
+	}
+}
+
+func BenchmarkTransposeNaive2048(b *testing.B) {
+	benchmarkTranspose(b, Transpose)
+}
+
+func BenchmarkTransposeBlocked2048(b *testing.B) {
+	benchmarkTranspose(b, TransposeBlocked)
+}
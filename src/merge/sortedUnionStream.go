@@ -0,0 +1,68 @@
+package merge
+
+import "container/heap"
+
+type cursor[T any] struct {
+	list  []T
+	index int
+}
+
+type cursorHeap[T any] struct {
+	cursors []cursor[T]
+	less    func(a, b T) bool
+}
+
+func (h *cursorHeap[T]) Len() int {
+	return len(h.cursors)
+}
+
+func (h *cursorHeap[T]) Less(i, j int) bool {
+	a, b := h.cursors[i], h.cursors[j]
+	return h.less(a.list[a.index], b.list[b.index])
+}
+
+func (h *cursorHeap[T]) Swap(i, j int) {
+	h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i]
+}
+
+func (h *cursorHeap[T]) Push(x any) {
+	h.cursors = append(h.cursors, x.(cursor[T]))
+}
+
+func (h *cursorHeap[T]) Pop() any {
+	last := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return last
+}
+
+// SortedUnionStream merges lists, each sorted by less, into one sorted slice
+// holding every distinct element once. A min-heap keeps the head of each list,
+// and an element equal to the last one emitted is dropped, which handles both
+// elements shared between lists and repeats within a list.
+func SortedUnionStream[T comparable](lists [][]T, less func(a, b T) bool) []T {
+	h := &cursorHeap[T]{less: less}
+	for _, list := range lists {
+		if len(list) > 0 {
+			h.cursors = append(h.cursors, cursor[T]{list: list})
+		}
+	}
+	heap.Init(h)
+
+	var out []T
+	for h.Len() > 0 {
+		top := &h.cursors[0]
+		value := top.list[top.index]
+		if len(out) == 0 || out[len(out)-1] != value {
+			out = append(out, value)
+		}
+
+		top.index++
+		if top.index < len(top.list) {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,69 @@
+package merge
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+func TestSortedUnionStream(t *testing.T) {
+	cases := []struct {
+		name  string
+		lists [][]int
+		want  []int
+	}{
+		{"overlapping", [][]int{{1, 3, 5, 7}, {3, 4, 5}, {0, 7, 9}}, []int{0, 1, 3, 4, 5, 7, 9}},
+		{"disjoint", [][]int{{1, 2}, {10, 11}, {5}}, []int{1, 2, 5, 10, 11}},
+		{"internal duplicates", [][]int{{1, 1, 1, 2}, {2, 2, 3, 3}}, []int{1, 2, 3}},
+		{"empty lists mixed in", [][]int{nil, {4, 6}, {}, {5}, nil}, []int{4, 5, 6}},
+		{"all empty", [][]int{nil, {}}, nil},
+		{"no lists", nil, nil},
+		{"single list", [][]int{{2, 2, 8}}, []int{2, 8}},
+	}
+	for _, c := range cases {
+		if got := SortedUnionStream(c.lists, intLess); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: SortedUnionStream(%v) = %v, want %v", c.name, c.lists, got, c.want)
+		}
+	}
+}
+
+func TestSortedUnionStreamRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(272))
+
+	trial := 0
+	for trial < 200 {
+		lists := make([][]int, r.Intn(6))
+		set := map[int]bool{}
+		for i := range lists {
+			list := make([]int, r.Intn(15))
+			for j := range list {
+				list[j] = r.Intn(30)
+				set[list[j]] = true
+			}
+			sort.Ints(list)
+			lists[i] = list
+		}
+
+		var want []int
+		for v := range set {
+			want = append(want, v)
+		}
+		sort.Ints(want)
+
+		if got := SortedUnionStream(lists, intLess); !reflect.DeepEqual(got, want) {
+			t.Fatalf("SortedUnionStream(%v) = %v, want %v", lists, got, want)
+		}
+		trial++
+	}
+}
+
+func TestSortedUnionStreamLeavesInputsAlone(t *testing.T) {
+	a, b := []int{1, 2, 3}, []int{2, 4}
+	SortedUnionStream([][]int{a, b}, intLess)
+	if !reflect.DeepEqual(a, []int{1, 2, 3}) || !reflect.DeepEqual(b, []int{2, 4}) {
+		t.Errorf("SortedUnionStream modified its inputs: %v, %v", a, b)
+	}
+}
@@ -0,0 +1,49 @@
+package numerical
+
+type IntegrationMethod int
+
+const (
+	Trapezoidal IntegrationMethod = iota
+	Simpson
+)
+
+// Integrate approximates the integral of f over [a, b] using n subintervals.
+// n is raised to 1 if smaller, and Simpson's rule bumps an odd n up to the
+// next even number.
+func Integrate(f func(float64) float64, a, b float64, n int, method IntegrationMethod) float64 {
+	if a == b {
+		return 0
+	}
+
+	if n < 1 {
+		n = 1
+	}
+	if method == Simpson && n%2 == 1 {
+		n++
+	}
+
+	h := (b - a) / float64(n)
+	sum := f(a) + f(b)
+
+	i := 1
+	for i < n {
+		x := a + float64(i)*h
+
+		if method == Simpson {
+			if i%2 == 1 {
+				sum += 4 * f(x)
+			} else {
+				sum += 2 * f(x)
+			}
+		} else {
+			sum += 2 * f(x)
+		}
+
+		i++
+	}
+
+	if method == Simpson {
+		return sum * h / 3
+	}
+	return sum * h / 2
+}
@@ -0,0 +1,58 @@
+package numerical
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntegrateSin(t *testing.T) {
+	trap := Integrate(math.Sin, 0, math.Pi, 100, Trapezoidal)
+	simp := Integrate(math.Sin, 0, math.Pi, 100, Simpson)
+
+	if math.Abs(trap-2) > 1e-3 {
+		t.Errorf("Trapezoidal = %v, want 2", trap)
+	}
+	if math.Abs(simp-2) > 1e-7 {
+		t.Errorf("Simpson = %v, want 2", simp)
+	}
+	if math.Abs(simp-2) >= math.Abs(trap-2) {
+		t.Errorf("Simpson error %v not below trapezoidal error %v", math.Abs(simp-2), math.Abs(trap-2))
+	}
+}
+
+func TestIntegrateExact(t *testing.T) {
+	// Simpson's rule is exact for cubics and the trapezoidal rule for lines.
+	cubic := func(x float64) float64 { return x*x*x - x }
+	if got := Integrate(cubic, 0, 2, 2, Simpson); math.Abs(got-2) > 1e-12 {
+		t.Errorf("Simpson ∫₀² x³-x = %v, want 2", got)
+	}
+	line := func(x float64) float64 { return 3*x + 1 }
+	if got := Integrate(line, 1, 3, 1, Trapezoidal); math.Abs(got-14) > 1e-12 {
+		t.Errorf("Trapezoidal ∫₁³ 3x+1 = %v, want 14", got)
+	}
+}
+
+func TestIntegrateEmptyInterval(t *testing.T) {
+	for _, method := range []IntegrationMethod{Trapezoidal, Simpson} {
+		if got := Integrate(math.Exp, 1.5, 1.5, 10, method); got != 0 {
+			t.Errorf("method %d over [a, a] = %v, want 0", method, got)
+		}
+	}
+}
+
+func TestIntegrateSubintervalCount(t *testing.T) {
+	odd := Integrate(math.Exp, 0, 1, 7, Simpson)
+	even := Integrate(math.Exp, 0, 1, 8, Simpson)
+	if odd != even {
+		t.Errorf("Simpson with n=7 = %v, want the n=8 result %v", odd, even)
+	}
+
+	if got, want := Integrate(math.Exp, 0, 1, 0, Trapezoidal), Integrate(math.Exp, 0, 1, 1, Trapezoidal); got != want {
+		t.Errorf("n=0 = %v, want the n=1 result %v", got, want)
+	}
+
+	reversed := Integrate(math.Exp, 1, 0, 100, Simpson)
+	if math.Abs(reversed+(math.E-1)) > 1e-9 {
+		t.Errorf("∫₁⁰ eˣ = %v, want %v", reversed, -(math.E - 1))
+	}
+}
@@ -0,0 +1,103 @@
+package numerical
+
+// Polynomial holds coefficients in increasing order of degree, so p[i] is the
+// coefficient of x^i. The zero polynomial is the empty slice.
+type Polynomial []float64
+
+func (p Polynomial) trim() Polynomial {
+	n := len(p)
+	for n > 0 && p[n-1] == 0 {
+		n--
+	}
+	return p[:n]
+}
+
+// Degree returns -1 for the zero polynomial.
+func (p Polynomial) Degree() int {
+	return len(p.trim()) - 1
+}
+
+func (p Polynomial) Eval(x float64) float64 {
+	result := 0.0
+
+	i := len(p) - 1
+	for i >= 0 {
+		result = result*x + p[i]
+		i--
+	}
+
+	return result
+}
+
+func (p Polynomial) Add(q Polynomial) Polynomial {
+	n := len(p)
+	if len(q) > n {
+		n = len(q)
+	}
+
+	sum := make(Polynomial, n)
+	copy(sum, p)
+
+	i := 0
+	for i < len(q) {
+		sum[i] += q[i]
+		i++
+	}
+
+	return sum.trim()
+}
+
+func (p Polynomial) Mul(q Polynomial) Polynomial {
+	p, q = p.trim(), q.trim()
+	if len(p) == 0 || len(q) == 0 {
+		return Polynomial{}
+	}
+
+	product := make(Polynomial, len(p)+len(q)-1)
+
+	i := 0
+	for i < len(p) {
+		j := 0
+		for j < len(q) {
+			product[i+j] += p[i] * q[j]
+			j++
+		}
+		i++
+	}
+
+	return product.trim()
+}
+
+func (p Polynomial) Derivative() Polynomial {
+	if len(p) <= 1 {
+		return Polynomial{}
+	}
+
+	d := make(Polynomial, len(p)-1)
+
+	i := 1
+	for i < len(p) {
+		d[i-1] = float64(i) * p[i]
+		i++
+	}
+
+	return d.trim()
+}
+
+// Integral returns the antiderivative with a zero constant term.
+func (p Polynomial) Integral() Polynomial {
+	p = p.trim()
+	if len(p) == 0 {
+		return Polynomial{}
+	}
+
+	integral := make(Polynomial, len(p)+1)
+
+	i := 0
+	for i < len(p) {
+		integral[i+1] = p[i] / float64(i+1)
+		i++
+	}
+
+	return integral
+}
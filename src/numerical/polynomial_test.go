@@ -0,0 +1,75 @@
+package numerical
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPolynomialEval(t *testing.T) {
+	p := Polynomial{1, -2, 3} // 3x² - 2x + 1
+	for _, x := range []float64{-2, 0, 0.5, 3} {
+		if got, want := p.Eval(x), 3*x*x-2*x+1; got != want {
+			t.Errorf("Eval(%v) = %v, want %v", x, got, want)
+		}
+	}
+
+	if got := (Polynomial{}).Eval(5); got != 0 {
+		t.Errorf("zero polynomial Eval = %v", got)
+	}
+	if got := (Polynomial{4}).Eval(5); got != 4 {
+		t.Errorf("constant Eval = %v", got)
+	}
+}
+
+func TestPolynomialDerivative(t *testing.T) {
+	if got := (Polynomial{0, 0, 1}).Derivative(); !reflect.DeepEqual(got, Polynomial{0, 2}) {
+		t.Errorf("d/dx x² = %v, want 2x", got)
+	}
+	if got := (Polynomial{7}).Derivative(); got.Degree() != -1 {
+		t.Errorf("derivative of a constant = %v, want zero", got)
+	}
+}
+
+func TestPolynomialMul(t *testing.T) {
+	got := (Polynomial{1, 1}).Mul(Polynomial{-1, 1})
+	if !reflect.DeepEqual(got, Polynomial{-1, 0, 1}) {
+		t.Errorf("(x+1)(x-1) = %v, want x²-1", got)
+	}
+	if got := (Polynomial{1, 2}).Mul(Polynomial{}); got.Degree() != -1 {
+		t.Errorf("p·0 = %v, want zero", got)
+	}
+}
+
+func TestPolynomialAdd(t *testing.T) {
+	got := (Polynomial{1, 2, 3}).Add(Polynomial{1, 1, -3})
+	if !reflect.DeepEqual(got, Polynomial{2, 3}) || got.Degree() != 1 {
+		t.Errorf("Add = %v, want 3x + 2", got)
+	}
+}
+
+func TestPolynomialIntegral(t *testing.T) {
+	got := (Polynomial{0, 2}).Integral()
+	if !reflect.DeepEqual(got, Polynomial{0, 0, 1}) {
+		t.Errorf("∫2x = %v, want x²", got)
+	}
+	if got := (Polynomial{3, 0, 6}).Integral().Derivative(); !reflect.DeepEqual(got, Polynomial{3, 0, 6}) {
+		t.Errorf("d/dx ∫p = %v", got)
+	}
+}
+
+func TestPolynomialDegree(t *testing.T) {
+	cases := []struct {
+		p    Polynomial
+		want int
+	}{
+		{Polynomial{}, -1},
+		{Polynomial{0, 0}, -1},
+		{Polynomial{5}, 0},
+		{Polynomial{1, 2, 0}, 1},
+	}
+	for _, c := range cases {
+		if got := c.p.Degree(); got != c.want {
+			t.Errorf("Degree(%v) = %d, want %d", c.p, got, c.want)
+		}
+	}
+}
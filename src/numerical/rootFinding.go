@@ -0,0 +1,77 @@
+package numerical
+
+import (
+	"errors"
+	"math"
+)
+
+const DERIVATIVE_EPSILON = 1e-12
+
+var (
+	ErrZeroDerivative = errors.New("numerical: derivative too close to zero")
+	ErrNoConvergence  = errors.New("numerical: did not converge")
+	ErrNoBracket      = errors.New("numerical: f(lo) and f(hi) must have opposite signs")
+)
+
+// NewtonRaphson stops with ErrZeroDerivative rather than taking a step that
+// would diverge; choosing a fallback such as Bisection is up to the caller.
+func NewtonRaphson(f, fprime func(float64) float64, x0 float64, tol float64, maxIter int) (float64, error) {
+	x := x0
+
+	i := 0
+	for i < maxIter {
+		d := fprime(x)
+		if math.Abs(d) < DERIVATIVE_EPSILON {
+			return x, ErrZeroDerivative
+		}
+
+		next := x - f(x)/d
+		if math.Abs(next-x) < tol {
+			return next, nil
+		}
+
+		x = next
+		i++
+	}
+
+	return x, ErrNoConvergence
+}
+
+// Bisection halves [lo, hi] until it is no wider than tol, or until it cannot
+// be halved any further in floating point, so a tol of zero is safe.
+func Bisection(f func(float64) float64, lo, hi, tol float64) (float64, error) {
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+
+	fLo, fHi := f(lo), f(hi)
+	if fLo == 0 {
+		return lo, nil
+	}
+	if fHi == 0 {
+		return hi, nil
+	}
+	if (fLo < 0) == (fHi < 0) {
+		return 0, ErrNoBracket
+	}
+
+	for hi-lo > tol {
+		mid := lo + (hi-lo)/2
+		if mid <= lo || mid >= hi {
+			break
+		}
+		fMid := f(mid)
+
+		if fMid == 0 {
+			return mid, nil
+		}
+
+		if (fMid < 0) == (fLo < 0) {
+			lo, fLo = mid, fMid
+		} else {
+			hi = mid
+		}
+	}
+
+	return lo + (hi-lo)/2, nil
+}
@@ -0,0 +1,72 @@
+package numerical
+
+import (
+	"math"
+	"testing"
+)
+
+func sqrt2Target(x float64) float64 { return x*x - 2 }
+
+func sqrt2TargetPrime(x float64) float64 { return 2 * x }
+
+func TestNewtonRaphsonSqrt2(t *testing.T) {
+	got, err := NewtonRaphson(sqrt2Target, sqrt2TargetPrime, 1, 1e-12, 50)
+	if err != nil {
+		t.Fatalf("NewtonRaphson: %v", err)
+	}
+	if math.Abs(got-math.Sqrt2) > 1e-10 {
+		t.Errorf("NewtonRaphson = %v, want %v", got, math.Sqrt2)
+	}
+}
+
+func TestNewtonRaphsonErrors(t *testing.T) {
+	if _, err := NewtonRaphson(sqrt2Target, sqrt2TargetPrime, 0, 1e-12, 50); err != ErrZeroDerivative {
+		t.Errorf("starting at a stationary point: err = %v, want ErrZeroDerivative", err)
+	}
+
+	// x³ - 2x + 2 cycles between 0 and 1 from x0 = 0.
+	f := func(x float64) float64 { return x*x*x - 2*x + 2 }
+	fprime := func(x float64) float64 { return 3*x*x - 2 }
+	if _, err := NewtonRaphson(f, fprime, 0, 1e-12, 100); err != ErrNoConvergence {
+		t.Errorf("cycling iteration: err = %v, want ErrNoConvergence", err)
+	}
+}
+
+func TestBisectionSqrt2(t *testing.T) {
+	got, err := Bisection(sqrt2Target, 0, 2, 1e-12)
+	if err != nil {
+		t.Fatalf("Bisection: %v", err)
+	}
+	if math.Abs(got-math.Sqrt2) > 1e-10 {
+		t.Errorf("Bisection = %v, want %v", got, math.Sqrt2)
+	}
+
+	got, err = Bisection(sqrt2Target, 2, 0, 1e-12)
+	if err != nil || math.Abs(got-math.Sqrt2) > 1e-10 {
+		t.Errorf("Bisection with reversed bounds = %v, %v", got, err)
+	}
+}
+
+func TestBisectionExactEndpoint(t *testing.T) {
+	got, err := Bisection(func(x float64) float64 { return x - 3 }, 3, 5, 1e-9)
+	if err != nil || got != 3 {
+		t.Errorf("Bisection = %v, %v, want 3", got, err)
+	}
+}
+
+func TestBisectionNoBracket(t *testing.T) {
+	if _, err := Bisection(sqrt2Target, 2, 3, 1e-9); err != ErrNoBracket {
+		t.Errorf("err = %v, want ErrNoBracket", err)
+	}
+}
+
+func TestBisectionTinyTolerance(t *testing.T) {
+	// A zero tolerance and one below the float spacing near the root must
+	// still terminate once the interval stops shrinking.
+	for _, tol := range []float64{0, 1e-300, -1} {
+		got, err := Bisection(sqrt2Target, 1e6, 0, tol)
+		if err != nil || math.Abs(got-math.Sqrt2) > 1e-15 {
+			t.Errorf("Bisection(tol=%v) = %v, %v", tol, got, err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package pipeline
+
+import "sync"
+
+// FanOutFanIn applies fn to every item from in across workers goroutines and
+// sends the results on the returned channel, which is closed once in has been
+// drained. Results arrive in completion order, not input order.
+func FanOutFanIn[T, U any](in <-chan T, workers int, fn func(T) U) <-chan U {
+	if workers < 1 {
+		workers = 1
+	}
+
+	out := make(chan U, workers)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	i := 0
+	for i < workers {
+		go func() {
+			defer wg.Done()
+			for item := range in {
+				out <- fn(item)
+			}
+		}()
+		i++
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
@@ -0,0 +1,137 @@
+package pipeline
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func feed(n int) <-chan int {
+	in := make(chan int)
+	go func() {
+		i := 0
+		for i < n {
+			in <- i
+			i++
+		}
+		close(in)
+	}()
+	return in
+}
+
+func TestFanOutFanInProcessesEachItemOnce(t *testing.T) {
+	const n = 1000
+	var calls int64
+
+	out := FanOutFanIn(feed(n), 8, func(x int) int {
+		atomic.AddInt64(&calls, 1)
+		return 2 * x
+	})
+
+	seen := make([]int, n)
+	for doubled := range out {
+		if doubled%2 != 0 || doubled/2 >= n {
+			t.Fatalf("unexpected result %d", doubled)
+		}
+		seen[doubled/2]++
+	}
+
+	for i, count := range seen {
+		if count != 1 {
+			t.Errorf("item %d produced %d results, want 1", i, count)
+		}
+	}
+	if calls != n {
+		t.Errorf("fn called %d times, want %d", calls, n)
+	}
+}
+
+func TestFanOutFanInClosesOutput(t *testing.T) {
+	in := make(chan int)
+	close(in)
+
+	out := FanOutFanIn(in, 4, func(x int) int { return x })
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Errorf("received a result from an empty input")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("output not closed after the input drained")
+	}
+}
+
+func TestFanOutFanInUsesWorkers(t *testing.T) {
+	const workers = 4
+	var active, peak int64
+	var mu sync.Mutex
+	release := make(chan struct{})
+
+	out := FanOutFanIn(feed(workers), workers, func(x int) int {
+		now := atomic.AddInt64(&active, 1)
+		mu.Lock()
+		if now > peak {
+			peak = now
+		}
+		if peak == workers {
+			close(release)
+		}
+		mu.Unlock()
+
+		select {
+		case <-release:
+		case <-time.After(5 * time.Second):
+		}
+		atomic.AddInt64(&active, -1)
+		return x
+	})
+
+	for range out {
+	}
+	if peak != workers {
+		t.Errorf("peak concurrency = %d, want %d", peak, workers)
+	}
+}
+
+func TestFanOutFanInConcurrentLoad(t *testing.T) {
+	// Several pipelines at once, each with shared state guarded by a mutex,
+	// give the race detector something to look at.
+	var wg sync.WaitGroup
+	p := 0
+	for p < 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var mu sync.Mutex
+			sum := 0
+			out := FanOutFanIn(feed(500), 16, func(x int) int {
+				mu.Lock()
+				sum += x
+				mu.Unlock()
+				return x
+			})
+
+			total := 0
+			for x := range out {
+				total += x
+			}
+			if want := 499 * 500 / 2; total != want || sum != want {
+				t.Errorf("total = %d, sum = %d, want %d", total, sum, want)
+			}
+		}()
+		p++
+	}
+	wg.Wait()
+}
+
+func TestFanOutFanInDefaultsToOneWorker(t *testing.T) {
+	count := 0
+	for range FanOutFanIn(feed(10), 0, func(x int) int { return x }) {
+		count++
+	}
+	if count != 10 {
+		t.Errorf("got %d results with workers=0, want 10", count)
+	}
+}
@@ -0,0 +1,151 @@
+package reactive
+
+import "errors"
+
+var (
+	ErrCycle       = errors.New("reactive: definition would create a cycle")
+	ErrUnknownCell = errors.New("reactive: unknown cell")
+	ErrNotInput    = errors.New("reactive: cell is computed and cannot be set")
+)
+
+type cell struct {
+	deps       []string
+	compute    func(inputs []float64) float64
+	value      float64
+	dependents []string
+}
+
+// Graph is a spreadsheet-style network of cells. Input cells hold values given
+// to Set; computed cells derive theirs from other cells. Every change is
+// pushed eagerly to the cells downstream of it, each recomputed once and only
+// after all of its own inputs, so Get is always current and costs nothing.
+type Graph struct {
+	cells map[string]*cell
+}
+
+func NewGraph() *Graph {
+	return &Graph{cells: map[string]*cell{}}
+}
+
+func (g *Graph) Get(name string) (float64, bool) {
+	c, ok := g.cells[name]
+	if !ok {
+		return 0, false
+	}
+	return c.value, true
+}
+
+// Set stores value in an input cell, creating it if needed, and updates
+// everything that depends on it.
+func (g *Graph) Set(name string, value float64) error {
+	c, ok := g.cells[name]
+	if !ok {
+		c = &cell{}
+		g.cells[name] = c
+	}
+	if c.compute != nil {
+		return ErrNotInput
+	}
+
+	c.value = value
+	g.propagate(name)
+	return nil
+}
+
+// Define makes name a computed cell over deps, which must already exist, and
+// computes it at once. Redefining a cell, even an input, updates its
+// dependents; it returns ErrCycle, leaving the graph unchanged, if one of deps
+// is name itself or depends on it.
+func (g *Graph) Define(name string, deps []string, compute func(inputs []float64) float64) error {
+	for _, d := range deps {
+		if _, ok := g.cells[d]; !ok {
+			return ErrUnknownCell
+		}
+	}
+
+	c, exists := g.cells[name]
+	if exists {
+		downstream := map[string]bool{name: true}
+		for _, n := range g.downstream(name) {
+			downstream[n] = true
+		}
+		for _, d := range deps {
+			if downstream[d] {
+				return ErrCycle
+			}
+		}
+
+		for _, d := range c.deps {
+			g.removeDependent(d, name)
+		}
+	} else {
+		c = &cell{}
+		g.cells[name] = c
+	}
+
+	c.deps = append([]string{}, deps...)
+	c.compute = compute
+	for _, d := range deps {
+		g.cells[d].dependents = append(g.cells[d].dependents, name)
+	}
+
+	g.recompute(c)
+	g.propagate(name)
+	return nil
+}
+
+func (g *Graph) removeDependent(dep, name string) {
+	c := g.cells[dep]
+	kept := c.dependents[:0]
+	removed := false
+	for _, d := range c.dependents {
+		if d == name && !removed {
+			removed = true
+			continue
+		}
+		kept = append(kept, d)
+	}
+	c.dependents = kept
+}
+
+// downstream lists the cells that depend on name, directly or not, in an order
+// where every cell comes after all of its inputs among them: the reverse of a
+// depth-first postorder over dependents.
+func (g *Graph) downstream(name string) []string {
+	visited := map[string]bool{name: true}
+	var postorder []string
+
+	var visit func(n string)
+	visit = func(n string) {
+		for _, d := range g.cells[n].dependents {
+			if !visited[d] {
+				visited[d] = true
+				visit(d)
+				postorder = append(postorder, d)
+			}
+		}
+	}
+	visit(name)
+
+	i, j := 0, len(postorder)-1
+	for i < j {
+		postorder[i], postorder[j] = postorder[j], postorder[i]
+		i++
+		j--
+	}
+	return postorder
+}
+
+func (g *Graph) recompute(c *cell) {
+	inputs := make([]float64, len(c.deps))
+	for i, d := range c.deps {
+		inputs[i] = g.cells[d].value
+	}
+	c.value = c.compute(inputs)
+}
+
+func (g *Graph) propagate(name string) {
+	for _, n := range g.downstream(name) {
+		g.recompute(g.cells[n])
+	}
+}
@@ -0,0 +1,130 @@
+package reactive
+
+import "testing"
+
+// network builds a, b → c = a+b → d = 2c, with f = c+d joining the diamond and
+// e = b+1 off to the side. calls counts the computations of each cell.
+func network(t *testing.T) (*Graph, map[string]int) {
+	t.Helper()
+
+	g := NewGraph()
+	calls := map[string]int{}
+	counted := func(name string, f func(in []float64) float64) func([]float64) float64 {
+		return func(in []float64) float64 {
+			calls[name]++
+			return f(in)
+		}
+	}
+
+	mustSet(t, g, "a", 1)
+	mustSet(t, g, "b", 2)
+	mustDefine(t, g, "c", []string{"a", "b"}, counted("c", func(in []float64) float64 { return in[0] + in[1] }))
+	mustDefine(t, g, "d", []string{"c"}, counted("d", func(in []float64) float64 { return 2 * in[0] }))
+	mustDefine(t, g, "e", []string{"b"}, counted("e", func(in []float64) float64 { return in[0] + 1 }))
+	mustDefine(t, g, "f", []string{"c", "d"}, counted("f", func(in []float64) float64 { return in[0] + in[1] }))
+
+	for name := range calls {
+		calls[name] = 0
+	}
+	return g, calls
+}
+
+func mustSet(t *testing.T, g *Graph, name string, value float64) {
+	t.Helper()
+	if err := g.Set(name, value); err != nil {
+		t.Fatalf("Set(%q, %v): %v", name, value, err)
+	}
+}
+
+func mustDefine(t *testing.T, g *Graph, name string, deps []string, compute func([]float64) float64) {
+	t.Helper()
+	if err := g.Define(name, deps, compute); err != nil {
+		t.Fatalf("Define(%q, %v): %v", name, deps, err)
+	}
+}
+
+func checkValues(t *testing.T, g *Graph, want map[string]float64) {
+	t.Helper()
+	for name, w := range want {
+		if got, ok := g.Get(name); !ok || got != w {
+			t.Errorf("Get(%q) = %v, %v, want %v", name, got, ok, w)
+		}
+	}
+}
+
+func TestGraphRecomputesOnlyDownstream(t *testing.T) {
+	g, calls := network(t)
+	checkValues(t, g, map[string]float64{"a": 1, "b": 2, "c": 3, "d": 6, "e": 3, "f": 9})
+
+	mustSet(t, g, "a", 10)
+	checkValues(t, g, map[string]float64{"c": 12, "d": 24, "e": 3, "f": 36})
+	// f depends on c both directly and through d, but is computed only once,
+	// after both.
+	want := map[string]int{"c": 1, "d": 1, "e": 0, "f": 1}
+	for name, w := range want {
+		if calls[name] != w {
+			t.Errorf("after Set(a): %s computed %d times, want %d", name, calls[name], w)
+		}
+	}
+
+	for name := range calls {
+		calls[name] = 0
+	}
+	mustSet(t, g, "b", 0)
+	checkValues(t, g, map[string]float64{"c": 10, "d": 20, "e": 1, "f": 30})
+	want = map[string]int{"c": 1, "d": 1, "e": 1, "f": 1}
+	for name, w := range want {
+		if calls[name] != w {
+			t.Errorf("after Set(b): %s computed %d times, want %d", name, calls[name], w)
+		}
+	}
+}
+
+func TestGraphRejectsCycles(t *testing.T) {
+	g, _ := network(t)
+
+	if err := g.Define("a", []string{"f"}, func(in []float64) float64 { return in[0] }); err != ErrCycle {
+		t.Errorf("Define(a, [f]) error = %v, want ErrCycle", err)
+	}
+	if err := g.Define("c", []string{"c"}, func(in []float64) float64 { return in[0] }); err != ErrCycle {
+		t.Errorf("Define(c, [c]) error = %v, want ErrCycle", err)
+	}
+
+	// The rejected definitions must leave a and c as they were.
+	mustSet(t, g, "a", 5)
+	checkValues(t, g, map[string]float64{"a": 5, "c": 7, "f": 21})
+}
+
+func TestGraphRedefine(t *testing.T) {
+	g, calls := network(t)
+
+	// Make d depend on e instead of c: it should stop following a.
+	mustDefine(t, g, "d", []string{"e"}, func(in []float64) float64 { return 100 * in[0] })
+	checkValues(t, g, map[string]float64{"d": 300, "f": 303})
+
+	for name := range calls {
+		calls[name] = 0
+	}
+	mustSet(t, g, "a", 4)
+	checkValues(t, g, map[string]float64{"c": 6, "d": 300, "f": 306})
+	if calls["c"] != 1 || calls["f"] != 1 {
+		t.Errorf("after redefining d, c and f computed %d and %d times, want 1 each", calls["c"], calls["f"])
+	}
+}
+
+func TestGraphErrors(t *testing.T) {
+	g, _ := network(t)
+
+	if err := g.Define("x", []string{"a", "missing"}, func([]float64) float64 { return 0 }); err != ErrUnknownCell {
+		t.Errorf("Define with an unknown dependency error = %v, want ErrUnknownCell", err)
+	}
+	if _, ok := g.Get("x"); ok {
+		t.Errorf("failed Define created cell x")
+	}
+	if err := g.Set("c", 1); err != ErrNotInput {
+		t.Errorf("Set on a computed cell error = %v, want ErrNotInput", err)
+	}
+	if _, ok := g.Get("missing"); ok {
+		t.Errorf("Get(missing) reported a value")
+	}
+}
@@ -0,0 +1,113 @@
+package sched
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+// Clock lets tests control how long tasks appear to have waited.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+type scheduled[T any] struct {
+	task     T
+	key      float64
+	sequence int
+}
+
+type taskHeap[T any] []scheduled[T]
+
+func (h taskHeap[T]) Len() int {
+	return len(h)
+}
+
+func (h taskHeap[T]) Less(i, j int) bool {
+	if h[i].key != h[j].key {
+		return h[i].key > h[j].key
+	}
+	return h[i].sequence < h[j].sequence
+}
+
+func (h taskHeap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *taskHeap[T]) Push(x any) {
+	*h = append(*h, x.(scheduled[T]))
+}
+
+func (h *taskHeap[T]) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+// PriorityScheduler hands out tasks by effective priority: the submitted
+// priority plus agingRate for every second the task has been waiting, so a
+// low-priority task eventually overtakes newer high-priority ones. Since
+// every waiting task ages at the same rate, the order between two tasks never
+// changes after submission and each task's position is fixed by the key
+// priority - agingRate * submissionTime. Ties go to the earlier submission.
+type PriorityScheduler[T any] struct {
+	mu        sync.Mutex
+	agingRate float64
+	clock     Clock
+	start     time.Time
+	tasks     taskHeap[T]
+	sequence  int
+}
+
+// NewPriorityScheduler uses the system clock when clock is nil.
+func NewPriorityScheduler[T any](agingRate float64, clock Clock) *PriorityScheduler[T] {
+	if clock == nil {
+		clock = systemClock{}
+	}
+
+	return &PriorityScheduler[T]{
+		agingRate: agingRate,
+		clock:     clock,
+		start:     clock.Now(),
+	}
+}
+
+func (s *PriorityScheduler[T]) Submit(task T, priority float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	waited := s.clock.Now().Sub(s.start).Seconds()
+	heap.Push(&s.tasks, scheduled[T]{
+		task:     task,
+		key:      priority - s.agingRate*waited,
+		sequence: s.sequence,
+	})
+	s.sequence++
+}
+
+// Next removes and returns the task with the highest effective priority, or
+// false if none is waiting.
+func (s *PriorityScheduler[T]) Next() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.tasks) == 0 {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(&s.tasks).(scheduled[T]).task, true
+}
+
+func (s *PriorityScheduler[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.tasks)
+}
@@ -0,0 +1,21 @@
+package searching
+
+// BinarySearchPredicate assumes pred is monotonic over [lo, hi] (false, then
+// true) and returns the first value for which it holds, or hi + 1 if it never
+// does.
+func BinarySearchPredicate(lo, hi int, pred func(int) bool) int {
+	low := lo
+	high := hi + 1
+
+	for low < high {
+		mid := low + (high-low)/2
+
+		if pred(mid) {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	return low
+}
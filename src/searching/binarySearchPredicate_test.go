@@ -0,0 +1,23 @@
+package searching
+
+import "testing"
+
+func TestBinarySearchPredicate(t *testing.T) {
+	cases := []struct {
+		name   string
+		lo, hi int
+		pred   func(int) bool
+		want   int
+	}{
+		{"threshold", 0, 100, func(x int) bool { return x*x >= 50 }, 8},
+		{"none", 0, 10, func(int) bool { return false }, 11},
+		{"all", 3, 10, func(int) bool { return true }, 3},
+		{"negative range", -20, -1, func(x int) bool { return x >= -7 }, -7},
+	}
+
+	for _, c := range cases {
+		if got := BinarySearchPredicate(c.lo, c.hi, c.pred); got != c.want {
+			t.Errorf("%s: got %d, want %d", c.name, got, c.want)
+		}
+	}
+}
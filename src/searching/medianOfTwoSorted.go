@@ -0,0 +1,55 @@
+package searching
+
+import "math"
+
+// MedianOfTwoSorted returns the median of the union of two ascending arrays
+// without merging them, in O(log min(m, n)). It binary-searches how many
+// elements of the shorter array belong to the lower half of the union; the
+// split is right once neither lower half has an element above the other
+// array's upper half. The median of two empty arrays is NaN.
+func MedianOfTwoSorted(a, b []int) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	total := len(a) + len(b)
+	if total == 0 {
+		return math.NaN()
+	}
+	half := (total + 1) / 2
+
+	low := 0
+	high := len(a)
+	for low <= high {
+		i := low + (high-low)/2
+		j := half - i
+
+		aLeft, aRight := math.MinInt, math.MaxInt
+		if i > 0 {
+			aLeft = a[i-1]
+		}
+		if i < len(a) {
+			aRight = a[i]
+		}
+		bLeft, bRight := math.MinInt, math.MaxInt
+		if j > 0 {
+			bLeft = b[j-1]
+		}
+		if j < len(b) {
+			bRight = b[j]
+		}
+
+		if aLeft > bRight {
+			high = i - 1
+		} else if bLeft > aRight {
+			low = i + 1
+		} else {
+			lower := max(aLeft, bLeft)
+			if total%2 == 1 {
+				return float64(lower)
+			}
+			return (float64(lower) + float64(min(aRight, bRight))) / 2
+		}
+	}
+
+	return math.NaN()
+}
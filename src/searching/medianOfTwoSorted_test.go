@@ -0,0 +1,74 @@
+package searching
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func mergedMedian(a, b []int) float64 {
+	all := append(append([]int(nil), a...), b...)
+	sort.Ints(all)
+	n := len(all)
+	if n%2 == 1 {
+		return float64(all[n/2])
+	}
+	return (float64(all[n/2-1]) + float64(all[n/2])) / 2
+}
+
+func sortedRandom(r *rand.Rand, n, lo, hi int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = lo + r.Intn(hi-lo)
+	}
+	sort.Ints(s)
+	return s
+}
+
+func TestMedianOfTwoSortedMatchesMerge(t *testing.T) {
+	r := rand.New(rand.NewSource(268))
+
+	trial := 0
+	for trial < 500 {
+		a := sortedRandom(r, r.Intn(20), -50, 50)
+		// Occasionally make one array far larger than the other.
+		bLen := r.Intn(20)
+		if trial%5 == 0 {
+			bLen = 200 + r.Intn(100)
+		}
+		b := sortedRandom(r, bLen, -50, 50)
+		if len(a)+len(b) == 0 {
+			trial++
+			continue
+		}
+
+		if got, want := MedianOfTwoSorted(a, b), mergedMedian(a, b); got != want {
+			t.Fatalf("MedianOfTwoSorted(%v, %v) = %v, want %v", a, b, got, want)
+		}
+		trial++
+	}
+}
+
+func TestMedianOfTwoSortedDisjoint(t *testing.T) {
+	cases := []struct {
+		a, b []int
+		want float64
+	}{
+		{[]int{1, 2, 3}, []int{10, 11, 12, 13}, 10},
+		{[]int{10, 11, 12, 13}, []int{1, 2, 3}, 10},
+		{[]int{1, 2}, []int{3, 4}, 2.5},
+		{[]int{5, 6}, nil, 5.5},
+		{nil, []int{7}, 7},
+		{[]int{1, 3}, []int{2}, 2},
+	}
+	for _, c := range cases {
+		if got := MedianOfTwoSorted(c.a, c.b); got != c.want {
+			t.Errorf("MedianOfTwoSorted(%v, %v) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+
+	if got := MedianOfTwoSorted(nil, nil); !math.IsNaN(got) {
+		t.Errorf("MedianOfTwoSorted(nil, nil) = %v, want NaN", got)
+	}
+}
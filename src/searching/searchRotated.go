@@ -0,0 +1,57 @@
+package searching
+
+// SearchRotated finds target in nums, an ascending array of distinct values
+// rotated at an unknown pivot, in O(log n). At each step at least one half of
+// the range is sorted, and comparing target with that half's ends decides
+// which half to keep. An unrotated array is simply the case where the left
+// half is always sorted.
+func SearchRotated(nums []int, target int) (int, bool) {
+	low := 0
+	high := len(nums) - 1
+
+	for low <= high {
+		mid := low + (high-low)/2
+		if nums[mid] == target {
+			return mid, true
+		}
+
+		if nums[low] <= nums[mid] {
+			if nums[low] <= target && target < nums[mid] {
+				high = mid - 1
+			} else {
+				low = mid + 1
+			}
+		} else {
+			if nums[mid] < target && target <= nums[high] {
+				low = mid + 1
+			} else {
+				high = mid - 1
+			}
+		}
+	}
+
+	return -1, false
+}
+
+// FindRotationPivot returns the index of the minimum of nums, a rotated
+// ascending array of distinct values: 0 if it was not rotated, and -1 if it
+// is empty.
+func FindRotationPivot(nums []int) int {
+	if len(nums) == 0 {
+		return -1
+	}
+
+	low := 0
+	high := len(nums) - 1
+
+	for low < high {
+		mid := low + (high-low)/2
+		if nums[mid] > nums[high] {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	return low
+}
@@ -0,0 +1,66 @@
+package searching
+
+import "testing"
+
+// rotated returns 0..n-1 in ascending order, scaled by 3 so that misses can
+// fall between elements, and rotated left by k.
+func rotated(n, k int) []int {
+	nums := make([]int, n)
+	i := 0
+	for i < n {
+		nums[i] = 3 * ((i + k) % n)
+		i++
+	}
+	return nums
+}
+
+func TestSearchRotatedEveryPivot(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 7, 16} {
+		k := 0
+		for k < n {
+			nums := rotated(n, k)
+
+			for i, v := range nums {
+				if got, ok := SearchRotated(nums, v); !ok || got != i {
+					t.Errorf("SearchRotated(%v, %d) = %d, %v, want %d, true", nums, v, got, ok, i)
+				}
+			}
+			for _, miss := range []int{-1, 1, 3*n - 2, 3 * n} {
+				if got, ok := SearchRotated(nums, miss); ok {
+					t.Errorf("SearchRotated(%v, %d) = %d, true, want not found", nums, miss, got)
+				}
+			}
+
+			want := 0
+			if k > 0 {
+				want = n - k
+			}
+			if got := FindRotationPivot(nums); got != want {
+				t.Errorf("FindRotationPivot(%v) = %d, want %d", nums, got, want)
+			}
+			k++
+		}
+	}
+}
+
+func TestSearchRotatedEmpty(t *testing.T) {
+	if got, ok := SearchRotated(nil, 5); ok || got != -1 {
+		t.Errorf("SearchRotated(nil, 5) = %d, %v, want -1, false", got, ok)
+	}
+	if got := FindRotationPivot(nil); got != -1 {
+		t.Errorf("FindRotationPivot(nil) = %d, want -1", got)
+	}
+}
+
+func TestSearchRotatedExample(t *testing.T) {
+	nums := []int{4, 5, 6, 7, 0, 1, 2}
+	if got, ok := SearchRotated(nums, 0); !ok || got != 4 {
+		t.Errorf("SearchRotated(%v, 0) = %d, %v, want 4, true", nums, got, ok)
+	}
+	if _, ok := SearchRotated(nums, 3); ok {
+		t.Errorf("SearchRotated(%v, 3) found a missing target", nums)
+	}
+	if got := FindRotationPivot(nums); got != 4 {
+		t.Errorf("FindRotationPivot(%v) = %d, want 4", nums, got)
+	}
+}
@@ -0,0 +1,75 @@
+package searching
+
+// keepLeft reports whether the extremum lies left of m2, comparing f(m1) and
+// f(m2) for a maximum or, if minimize is set, for a minimum.
+func keepLeft(f func(float64) float64, m1, m2 float64, minimize bool) bool {
+	if minimize {
+		return f(m1) < f(m2)
+	}
+	return f(m1) > f(m2)
+}
+
+func ternaryIterations(lo, hi float64, f func(float64) float64, iterations int, minimize bool) float64 {
+	i := 0
+	for i < iterations {
+		m1 := lo + (hi-lo)/3
+		m2 := hi - (hi-lo)/3
+
+		if keepLeft(f, m1, m2, minimize) {
+			hi = m2
+		} else {
+			lo = m1
+		}
+		i++
+	}
+
+	return (lo + hi) / 2
+}
+
+func ternaryEpsilon(lo, hi float64, f func(float64) float64, epsilon float64, minimize bool) float64 {
+	if !(epsilon > 0) {
+		panic("searching: epsilon must be positive")
+	}
+
+	for hi-lo > epsilon {
+		m1 := lo + (hi-lo)/3
+		m2 := hi - (hi-lo)/3
+		if m1 <= lo || m2 >= hi {
+			break
+		}
+
+		if keepLeft(f, m1, m2, minimize) {
+			hi = m2
+		} else {
+			lo = m1
+		}
+	}
+
+	return (lo + hi) / 2
+}
+
+// TernarySearch locates the maximum of f on [lo, hi] after a fixed number of
+// iterations; use TernarySearchMin for a minimum. f must be unimodal on the
+// interval; otherwise the result is undefined.
+func TernarySearch(lo, hi float64, f func(float64) float64, iterations int) float64 {
+	return ternaryIterations(lo, hi, f, iterations, false)
+}
+
+// TernarySearchMin is TernarySearch for the minimum of f.
+func TernarySearchMin(lo, hi float64, f func(float64) float64, iterations int) float64 {
+	return ternaryIterations(lo, hi, f, iterations, true)
+}
+
+// TernarySearchEpsilon is TernarySearch, locating the maximum, that stops once
+// the interval is no wider than epsilon, with the same unimodality
+// requirement. It also stops when the interval cannot shrink any further in
+// floating point, so an epsilon below the spacing of floats around the
+// extremum is safe. It panics if epsilon is not positive.
+func TernarySearchEpsilon(lo, hi float64, f func(float64) float64, epsilon float64) float64 {
+	return ternaryEpsilon(lo, hi, f, epsilon, false)
+}
+
+// TernarySearchEpsilonMin is TernarySearchEpsilon for the minimum of f.
+func TernarySearchEpsilonMin(lo, hi float64, f func(float64) float64, epsilon float64) float64 {
+	return ternaryEpsilon(lo, hi, f, epsilon, true)
+}
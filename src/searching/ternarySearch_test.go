@@ -0,0 +1,61 @@
+package searching
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTernarySearchParabola(t *testing.T) {
+	f := func(x float64) float64 {
+		return -(x - 2) * (x - 2)
+	}
+
+	if x := TernarySearch(-10, 10, f, 100); math.Abs(x-2) > 1e-6 {
+		t.Errorf("TernarySearch = %v, want 2", x)
+	}
+	if x := TernarySearchEpsilon(-10, 10, f, 1e-9); math.Abs(x-2) > 1e-6 {
+		t.Errorf("TernarySearchEpsilon = %v, want 2", x)
+	}
+}
+
+func TestTernarySearchNonTrivial(t *testing.T) {
+	// x·e^(-x) peaks at x = 1, and x² - 3x at its minimum 1.5.
+	peak := func(x float64) float64 {
+		return x * math.Exp(-x)
+	}
+	if x := TernarySearch(0, 5, peak, 200); math.Abs(x-1) > 1e-6 {
+		t.Errorf("maximum at %v, want 1", x)
+	}
+
+	bowl := func(x float64) float64 {
+		return x*x - 3*x
+	}
+	if x := TernarySearchEpsilonMin(-4, 4, bowl, 1e-9); math.Abs(x-1.5) > 1e-6 {
+		t.Errorf("minimum at %v, want 1.5", x)
+	}
+	if x := TernarySearchMin(-4, 4, bowl, 200); math.Abs(x-1.5) > 1e-6 {
+		t.Errorf("TernarySearchMin = %v, want 1.5", x)
+	}
+}
+
+func TestTernarySearchEpsilonBelowFloatSpacing(t *testing.T) {
+	f := func(x float64) float64 {
+		return -(x - 1e6 - 3) * (x - 1e6 - 3)
+	}
+	if x := TernarySearchEpsilon(1e6, 1e6+10, f, 1e-12); math.Abs(x-1e6-3) > 1e-6 {
+		t.Errorf("got %v, want %v", x, 1e6+3)
+	}
+}
+
+func TestTernarySearchEpsilonRejectsBadEpsilon(t *testing.T) {
+	for _, epsilon := range []float64{0, -1, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("epsilon %v did not panic", epsilon)
+				}
+			}()
+			TernarySearchEpsilon(0, 1, math.Sin, epsilon)
+		}()
+	}
+}
@@ -0,0 +1,22 @@
+package selection
+
+import "sort"
+
+// PartialSort reorders s in place so that s[:k] holds its k smallest elements
+// in ascending order; the order of the rest is unspecified. It costs O(n + k
+// log k) instead of a full sort's O(n log n). k is clamped to [0, len(s)].
+func PartialSort[T any](s []T, k int, less func(a, b T) bool) {
+	if k < 0 {
+		k = 0
+	}
+	if k > len(s) {
+		k = len(s)
+	}
+
+	quickselect(s, k, less)
+
+	head := s[:k]
+	sort.Slice(head, func(i, j int) bool {
+		return less(head[i], head[j])
+	})
+}
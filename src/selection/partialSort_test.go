@@ -0,0 +1,57 @@
+package selection
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPartialSort(t *testing.T) {
+	r := rand.New(rand.NewSource(269))
+
+	trial := 0
+	for trial < 100 {
+		n := r.Intn(60)
+		s := make([]int, n)
+		for i := range s {
+			s[i] = r.Intn(25)
+		}
+		sorted := append([]int{}, s...)
+		sort.Ints(sorted)
+
+		for _, k := range []int{0, 1, n / 3, n} {
+			if k > n {
+				continue
+			}
+			got := append([]int{}, s...)
+			PartialSort(got, k, intLess)
+
+			if !reflect.DeepEqual(got[:k], sorted[:k]) {
+				t.Fatalf("PartialSort(%v, %d) prefix = %v, want %v", s, k, got[:k], sorted[:k])
+			}
+			// The rest is unordered, but must still be the remaining elements.
+			rest := append([]int{}, got[k:]...)
+			sort.Ints(rest)
+			if !reflect.DeepEqual(rest, sorted[k:]) {
+				t.Fatalf("PartialSort(%v, %d) tail = %v, want a permutation of %v", s, k, got[k:], sorted[k:])
+			}
+		}
+		trial++
+	}
+}
+
+func TestPartialSortClampsK(t *testing.T) {
+	s := []int{3, 1, 2}
+	PartialSort(s, 10, intLess)
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(s, want) {
+		t.Errorf("PartialSort with k > len = %v, want %v", s, want)
+	}
+
+	s = []int{3, 1, 2}
+	PartialSort(s, -1, intLess)
+	sort.Ints(s)
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(s, want) {
+		t.Errorf("PartialSort with k < 0 lost elements: %v", s)
+	}
+}
@@ -0,0 +1,39 @@
+package selection
+
+import "math/rand"
+
+// quickselect rearranges s so that s[:k] holds its k smallest elements under
+// less, in no particular order. The three-way partition keeps inputs with many
+// equal elements from degrading to quadratic time.
+func quickselect[T any](s []T, k int, less func(a, b T) bool) {
+	lo, hi := 0, len(s)
+	if k <= lo || k >= hi {
+		return
+	}
+
+	for hi-lo > 1 {
+		pivot := s[lo+rand.Intn(hi-lo)]
+
+		lt, i, gt := lo, lo, hi
+		for i < gt {
+			if less(s[i], pivot) {
+				s[lt], s[i] = s[i], s[lt]
+				lt++
+				i++
+			} else if less(pivot, s[i]) {
+				gt--
+				s[i], s[gt] = s[gt], s[i]
+			} else {
+				i++
+			}
+		}
+
+		if k < lt {
+			hi = lt
+		} else if k > gt {
+			lo = gt
+		} else {
+			return
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package selection
+
+// TopK returns the k largest elements of s in no particular order, leaving s
+// untouched. k is clamped to [0, len(s)].
+func TopK[T any](s []T, k int, less func(a, b T) bool) []T {
+	c := make([]T, len(s))
+	copy(c, s)
+	return TopKInPlace(c, k, less)
+}
+
+// TopKInPlace is TopK without the copy: it reorders s and returns a prefix of
+// it.
+func TopKInPlace[T any](s []T, k int, less func(a, b T) bool) []T {
+	if k < 0 {
+		k = 0
+	}
+	if k > len(s) {
+		k = len(s)
+	}
+
+	quickselect(s, k, func(a, b T) bool {
+		return less(b, a)
+	})
+
+	return s[:k]
+}
@@ -0,0 +1,72 @@
+package selection
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+func sortedTopK(s []int, k int) []int {
+	c := append([]int{}, s...)
+	sort.Sort(sort.Reverse(sort.IntSlice(c)))
+	top := c[:k]
+	sort.Ints(top)
+	return top
+}
+
+func TestTopK(t *testing.T) {
+	r := rand.New(rand.NewSource(234))
+
+	trial := 0
+	for trial < 100 {
+		n := r.Intn(50)
+		s := make([]int, n)
+		for i := range s {
+			s[i] = r.Intn(20)
+		}
+		original := append([]int{}, s...)
+
+		for _, k := range []int{0, 1, n / 2, n} {
+			if k > n {
+				continue
+			}
+			got := TopK(s, k, intLess)
+			sort.Ints(got)
+			if want := sortedTopK(s, k); !reflect.DeepEqual(got, want) {
+				t.Fatalf("TopK(%v, %d) = %v, want %v", s, k, got, want)
+			}
+		}
+		if !reflect.DeepEqual(s, original) {
+			t.Fatalf("TopK modified its input")
+		}
+		trial++
+	}
+}
+
+func TestTopKInPlace(t *testing.T) {
+	s := []int{5, 1, 9, 3, 7, 9, 2}
+	got := TopKInPlace(s, 3, intLess)
+	if &got[0] != &s[0] {
+		t.Errorf("TopKInPlace did not return a prefix of its input")
+	}
+
+	sort.Ints(got)
+	if want := []int{7, 9, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TopKInPlace = %v, want %v", got, want)
+	}
+}
+
+func TestTopKClamps(t *testing.T) {
+	s := []int{3, 1, 2}
+	if got := TopK(s, -2, intLess); len(got) != 0 {
+		t.Errorf("TopK(k=-2) = %v, want empty", got)
+	}
+	got := TopK(s, 10, intLess)
+	sort.Ints(got)
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("TopK(k=10) = %v, want all elements", got)
+	}
+}
@@ -0,0 +1,112 @@
+package sketch
+
+import (
+	"math"
+	"sort"
+)
+
+type tuple struct {
+	value float64
+	g     int
+	delta int
+}
+
+// Quantile is a Greenwald-Khanna summary. Every answer has a rank within
+// epsilon*n of the requested one, using O((1/epsilon) log(epsilon*n)) space.
+type Quantile struct {
+	epsilon  float64
+	n        int
+	tuples   []tuple
+	min, max float64
+	period   int
+}
+
+// NewQuantile creates a summary with rank error epsilon*n. It panics unless
+// epsilon is strictly between 0 and 1: with epsilon at or below 0 nothing
+// could ever be merged, and at 1 or above any element is an acceptable answer.
+func NewQuantile(epsilon float64) *Quantile {
+	if !(epsilon > 0 && epsilon < 1) {
+		panic("sketch: epsilon must be between 0 and 1")
+	}
+
+	period := int(1 / (2 * epsilon))
+	if period < 1 {
+		period = 1
+	}
+
+	return &Quantile{epsilon: epsilon, period: period}
+}
+
+func (s *Quantile) Add(x float64) {
+	if s.n == 0 || x < s.min {
+		s.min = x
+	}
+	if s.n == 0 || x > s.max {
+		s.max = x
+	}
+
+	i := sort.Search(len(s.tuples), func(i int) bool {
+		return s.tuples[i].value > x
+	})
+
+	delta := 0
+	if i > 0 && i < len(s.tuples) {
+		delta = int(math.Floor(2 * s.epsilon * float64(s.n)))
+	}
+
+	s.tuples = append(s.tuples, tuple{})
+	copy(s.tuples[i+1:], s.tuples[i:])
+	s.tuples[i] = tuple{value: x, g: 1, delta: delta}
+	s.n++
+
+	if s.n%s.period == 0 {
+		s.compress()
+	}
+}
+
+func (s *Quantile) compress() {
+	limit := int(math.Floor(2 * s.epsilon * float64(s.n)))
+
+	i := len(s.tuples) - 2
+	for i >= 1 {
+		if s.tuples[i].g+s.tuples[i+1].g+s.tuples[i+1].delta <= limit {
+			s.tuples[i+1].g += s.tuples[i].g
+			s.tuples = append(s.tuples[:i], s.tuples[i+1:]...)
+		}
+		i--
+	}
+}
+
+// Quantile returns a value whose rank is within epsilon*n of ceil(q*n), with q
+// clamped to [0, 1]. The extremes q = 0 and q = 1 are exact. An empty sketch
+// returns NaN.
+func (s *Quantile) Quantile(q float64) float64 {
+	if s.n == 0 {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return s.min
+	}
+	if q >= 1 {
+		return s.max
+	}
+
+	bound := math.Ceil(q*float64(s.n)) + s.epsilon*float64(s.n)
+	rMin := 0
+
+	i := 0
+	for i < len(s.tuples)-1 {
+		rMin += s.tuples[i].g
+		next := s.tuples[i+1]
+		if float64(rMin+next.g+next.delta) > bound {
+			return s.tuples[i].value
+		}
+		i++
+	}
+
+	return s.tuples[len(s.tuples)-1].value
+}
+
+func (s *Quantile) Count() int {
+	return s.n
+}
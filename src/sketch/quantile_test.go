@@ -0,0 +1,81 @@
+package sketch
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestQuantileUniform(t *testing.T) {
+	const (
+		n       = 100000
+		epsilon = 0.005
+	)
+	r := rand.New(rand.NewSource(243))
+	s := NewQuantile(epsilon)
+
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = r.Float64()
+		s.Add(values[i])
+	}
+	sort.Float64s(values)
+
+	for _, q := range []float64{0.5, 0.9, 0.99} {
+		got := s.Quantile(q)
+		rank := sort.SearchFloat64s(values, got)
+		if math.Abs(float64(rank)-q*n) > epsilon*n+1 {
+			t.Errorf("Quantile(%v) = %v at rank %d, want within %v of %v", q, got, rank, epsilon*n, q*n)
+		}
+		if math.Abs(got-q) > 2*epsilon {
+			t.Errorf("Quantile(%v) = %v, far from the uniform quantile", q, got)
+		}
+	}
+
+	if len(s.tuples) > n/20 {
+		t.Errorf("summary keeps %d tuples for %d values", len(s.tuples), n)
+	}
+	if s.Count() != n {
+		t.Errorf("Count = %d, want %d", s.Count(), n)
+	}
+}
+
+func TestQuantileExtremes(t *testing.T) {
+	s := NewQuantile(0.01)
+	for _, x := range []float64{5, -3, 12, 7, 0} {
+		s.Add(x)
+	}
+
+	if got := s.Quantile(0); got != -3 {
+		t.Errorf("Quantile(0) = %v, want the minimum -3", got)
+	}
+	if got := s.Quantile(1); got != 12 {
+		t.Errorf("Quantile(1) = %v, want the maximum 12", got)
+	}
+	if got := s.Quantile(-1); got != -3 {
+		t.Errorf("Quantile(-1) = %v, want it clamped to the minimum", got)
+	}
+	if got := s.Quantile(0.5); got != 5 {
+		t.Errorf("Quantile(0.5) of five values = %v, want 5", got)
+	}
+}
+
+func TestQuantileEmpty(t *testing.T) {
+	if got := NewQuantile(0.01).Quantile(0.5); !math.IsNaN(got) {
+		t.Errorf("Quantile on an empty sketch = %v, want NaN", got)
+	}
+}
+
+func TestNewQuantileRejectsBadEpsilon(t *testing.T) {
+	for _, epsilon := range []float64{0, -0.1, 1, 2, math.NaN(), math.Inf(1)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewQuantile(%v) did not panic", epsilon)
+				}
+			}()
+			NewQuantile(epsilon)
+		}()
+	}
+}
@@ -0,0 +1,74 @@
+package sqrtdecomp
+
+import "math"
+
+// Array splits its values into blocks of about √n elements and keeps the sum
+// of each block, so a point update touches one block sum and a range sum adds
+// at most two partial blocks plus the whole blocks between them.
+type Array struct {
+	values    []int
+	blocks    []int
+	blockSize int
+}
+
+func NewArray(values []int) *Array {
+	blockSize := int(math.Sqrt(float64(len(values))))
+	if blockSize < 1 {
+		blockSize = 1
+	}
+
+	a := &Array{
+		values:    make([]int, len(values)),
+		blocks:    make([]int, (len(values)+blockSize-1)/blockSize),
+		blockSize: blockSize,
+	}
+	copy(a.values, values)
+
+	i := 0
+	for i < len(values) {
+		a.blocks[i/blockSize] += values[i]
+		i++
+	}
+
+	return a
+}
+
+func (a *Array) Len() int {
+	return len(a.values)
+}
+
+func (a *Array) Get(i int) int {
+	return a.values[i]
+}
+
+// Update sets the element at i to value in O(1).
+func (a *Array) Update(i, value int) {
+	a.blocks[i/a.blockSize] += value - a.values[i]
+	a.values[i] = value
+}
+
+// RangeSum returns the sum of the elements in [l, r], both inclusive, in
+// O(√n). It panics if the range is out of bounds, and is 0 when l > r.
+func (a *Array) RangeSum(l, r int) int {
+	if l > r {
+		return 0
+	}
+	_ = a.values[l]
+	_ = a.values[r]
+
+	sum := 0
+	for l <= r && l%a.blockSize != 0 {
+		sum += a.values[l]
+		l++
+	}
+	for l+a.blockSize-1 <= r {
+		sum += a.blocks[l/a.blockSize]
+		l += a.blockSize
+	}
+	for l <= r {
+		sum += a.values[l]
+		l++
+	}
+
+	return sum
+}
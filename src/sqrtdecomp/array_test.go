@@ -0,0 +1,90 @@
+package sqrtdecomp
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestArrayAgainstBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(253))
+
+	for _, n := range []int{1, 2, 10, 17, 100} {
+		reference := make([]int, n)
+		for i := range reference {
+			reference[i] = r.Intn(200) - 100
+		}
+		a := NewArray(reference)
+
+		op := 0
+		for op < 500 {
+			if r.Intn(2) == 0 {
+				i, v := r.Intn(n), r.Intn(200)-100
+				reference[i] = v
+				a.Update(i, v)
+			} else {
+				l := r.Intn(n)
+				right := l + r.Intn(n-l)
+				want := 0
+				for _, v := range reference[l : right+1] {
+					want += v
+				}
+				if got := a.RangeSum(l, right); got != want {
+					t.Fatalf("n=%d: RangeSum(%d, %d) = %d, want %d", n, l, right, got, want)
+				}
+			}
+			op++
+		}
+
+		for i, v := range reference {
+			if a.Get(i) != v {
+				t.Fatalf("Get(%d) = %d, want %d", i, a.Get(i), v)
+			}
+		}
+	}
+}
+
+func TestArrayRangeShapes(t *testing.T) {
+	// 100 elements give blocks of 10.
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = i
+	}
+	a := NewArray(values)
+
+	cases := []struct {
+		l, r, want int
+	}{
+		{12, 17, 12 + 13 + 14 + 15 + 16 + 17},
+		{20, 29, 245},
+		{5, 94, (5 + 94) * 90 / 2},
+		{0, 99, 4950},
+		{42, 42, 42},
+		{7, 3, 0},
+	}
+	for _, c := range cases {
+		if got := a.RangeSum(c.l, c.r); got != c.want {
+			t.Errorf("RangeSum(%d, %d) = %d, want %d", c.l, c.r, got, c.want)
+		}
+	}
+	if a.Len() != 100 {
+		t.Errorf("Len = %d, want 100", a.Len())
+	}
+}
+
+func TestArrayCopiesInput(t *testing.T) {
+	values := []int{1, 2, 3}
+	a := NewArray(values)
+	values[0] = 100
+	if got := a.RangeSum(0, 2); got != 6 {
+		t.Errorf("RangeSum after mutating the input = %d, want 6", got)
+	}
+}
+
+func TestArrayRangeSumOutOfBounds(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RangeSum past the end did not panic")
+		}
+	}()
+	NewArray([]int{1, 2, 3}).RangeSum(1, 3)
+}
@@ -0,0 +1,135 @@
+package stream
+
+import "container/heap"
+
+type intHeap struct {
+	values []int
+	max    bool
+}
+
+func (h intHeap) Len() int {
+	return len(h.values)
+}
+
+func (h intHeap) Less(i, j int) bool {
+	if h.max {
+		return h.values[i] > h.values[j]
+	}
+	return h.values[i] < h.values[j]
+}
+
+func (h intHeap) Swap(i, j int) {
+	h.values[i], h.values[j] = h.values[j], h.values[i]
+}
+
+func (h *intHeap) Push(x any) {
+	h.values = append(h.values, x.(int))
+}
+
+func (h *intHeap) Pop() any {
+	last := h.values[len(h.values)-1]
+	h.values = h.values[:len(h.values)-1]
+	return last
+}
+
+func (h intHeap) top() int {
+	return h.values[0]
+}
+
+// window keeps the lower half of the elements in a max-heap and the upper half
+// in a min-heap. Elements leaving the window are only recorded in delayed and
+// physically removed once they surface at the top of their heap, so the sizes
+// track live elements rather than heap lengths.
+type window struct {
+	small, large         *intHeap
+	smallSize, largeSize int
+	delayed              map[int]int
+}
+
+func (w *window) prune(h *intHeap) {
+	for h.Len() > 0 && w.delayed[h.top()] > 0 {
+		w.delayed[h.top()]--
+		heap.Pop(h)
+	}
+}
+
+func (w *window) balance() {
+	if w.smallSize > w.largeSize+1 {
+		heap.Push(w.large, heap.Pop(w.small))
+		w.smallSize--
+		w.largeSize++
+		w.prune(w.small)
+	} else if w.smallSize < w.largeSize {
+		heap.Push(w.small, heap.Pop(w.large))
+		w.largeSize--
+		w.smallSize++
+		w.prune(w.large)
+	}
+}
+
+func (w *window) insert(x int) {
+	if w.small.Len() == 0 || x <= w.small.top() {
+		heap.Push(w.small, x)
+		w.smallSize++
+	} else {
+		heap.Push(w.large, x)
+		w.largeSize++
+	}
+	w.balance()
+}
+
+func (w *window) erase(x int) {
+	w.delayed[x]++
+
+	if x <= w.small.top() {
+		w.smallSize--
+		if x == w.small.top() {
+			w.prune(w.small)
+		}
+	} else {
+		w.largeSize--
+		if x == w.large.top() {
+			w.prune(w.large)
+		}
+	}
+	w.balance()
+}
+
+func (w *window) median(k int) float64 {
+	if k%2 == 1 {
+		return float64(w.small.top())
+	}
+	return (float64(w.small.top()) + float64(w.large.top())) / 2
+}
+
+// SlidingMedian returns the median of every window of k consecutive elements,
+// averaging the two middle elements when k is even. It returns nil if k is not
+// in [1, len(nums)].
+func SlidingMedian(nums []int, k int) []float64 {
+	if k < 1 || k > len(nums) {
+		return nil
+	}
+
+	w := &window{
+		small:   &intHeap{max: true},
+		large:   &intHeap{},
+		delayed: map[int]int{},
+	}
+	medians := make([]float64, 0, len(nums)-k+1)
+
+	i := 0
+	for i < k {
+		w.insert(nums[i])
+		i++
+	}
+	medians = append(medians, w.median(k))
+
+	for i < len(nums) {
+		w.insert(nums[i])
+		w.erase(nums[i-k])
+		medians = append(medians, w.median(k))
+		i++
+	}
+
+	return medians
+}
@@ -0,0 +1,83 @@
+package stream
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func bruteSlidingMedian(nums []int, k int) []float64 {
+	var medians []float64
+	i := 0
+	for i+k <= len(nums) {
+		w := append([]int{}, nums[i:i+k]...)
+		sort.Ints(w)
+		if k%2 == 1 {
+			medians = append(medians, float64(w[k/2]))
+		} else {
+			medians = append(medians, (float64(w[k/2-1])+float64(w[k/2]))/2)
+		}
+		i++
+	}
+	return medians
+}
+
+func TestSlidingMedianKnown(t *testing.T) {
+	got := SlidingMedian([]int{1, 3, -1, -3, 5, 3, 6, 7}, 3)
+	want := []float64{1, -1, -1, 3, 5, 6}
+	if len(got) != len(want) {
+		t.Fatalf("SlidingMedian = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("SlidingMedian = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestSlidingMedianRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(233))
+
+	trial := 0
+	for trial < 300 {
+		n := 1 + r.Intn(60)
+		nums := make([]int, n)
+		for i := range nums {
+			// A narrow range forces plenty of duplicates through the lazy
+			// deletion bookkeeping.
+			nums[i] = r.Intn(10) - 5
+		}
+		k := 1 + r.Intn(n)
+
+		got := SlidingMedian(nums, k)
+		want := bruteSlidingMedian(nums, k)
+		if len(got) != len(want) {
+			t.Fatalf("SlidingMedian(%v, %d) has %d windows, want %d", nums, k, len(got), len(want))
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("SlidingMedian(%v, %d)[%d] = %v, want %v", nums, k, i, got[i], want[i])
+			}
+		}
+		trial++
+	}
+}
+
+func TestSlidingMedianEvenWindow(t *testing.T) {
+	got := SlidingMedian([]int{1, 2, 4, 8}, 2)
+	want := []float64{1.5, 3, 6}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("SlidingMedian = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSlidingMedianInvalidWindow(t *testing.T) {
+	for _, k := range []int{0, -1, 4} {
+		if got := SlidingMedian([]int{1, 2, 3}, k); got != nil {
+			t.Errorf("SlidingMedian(k=%d) = %v, want nil", k, got)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package stream
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// Counter is an estimate for one tracked item. Count never underestimates the
+// true frequency and overestimates it by at most Error.
+type Counter[T comparable] struct {
+	Item  T
+	Count int
+	Error int
+}
+
+type counterHeap[T comparable] struct {
+	counters []*Counter[T]
+	index    map[T]int
+}
+
+func (h *counterHeap[T]) Len() int {
+	return len(h.counters)
+}
+
+func (h *counterHeap[T]) Less(i, j int) bool {
+	return h.counters[i].Count < h.counters[j].Count
+}
+
+func (h *counterHeap[T]) Swap(i, j int) {
+	h.counters[i], h.counters[j] = h.counters[j], h.counters[i]
+	h.index[h.counters[i].Item] = i
+	h.index[h.counters[j].Item] = j
+}
+
+func (h *counterHeap[T]) Push(x any) {
+	c := x.(*Counter[T])
+	h.index[c.Item] = len(h.counters)
+	h.counters = append(h.counters, c)
+}
+
+func (h *counterHeap[T]) Pop() any {
+	last := h.counters[len(h.counters)-1]
+	h.counters = h.counters[:len(h.counters)-1]
+	delete(h.index, last.Item)
+	return last
+}
+
+// SpaceSaving tracks approximate heavy hitters with at most capacity counters.
+// An unseen item arriving when all counters are taken replaces the item with
+// the smallest count and inherits that count as its error bound.
+type SpaceSaving[T comparable] struct {
+	capacity int
+	counters *counterHeap[T]
+}
+
+func NewSpaceSaving[T comparable](capacity int) *SpaceSaving[T] {
+	return &SpaceSaving[T]{
+		capacity: capacity,
+		counters: &counterHeap[T]{index: map[T]int{}},
+	}
+}
+
+func (s *SpaceSaving[T]) Observe(item T) {
+	h := s.counters
+
+	if i, ok := h.index[item]; ok {
+		h.counters[i].Count++
+		heap.Fix(h, i)
+		return
+	}
+
+	if h.Len() < s.capacity {
+		heap.Push(h, &Counter[T]{Item: item, Count: 1})
+		return
+	}
+
+	if s.capacity <= 0 {
+		return
+	}
+
+	smallest := h.counters[0]
+	delete(h.index, smallest.Item)
+	h.counters[0] = &Counter[T]{Item: item, Count: smallest.Count + 1, Error: smallest.Count}
+	h.index[item] = 0
+	heap.Fix(h, 0)
+}
+
+// TopK returns up to k counters with the highest counts, highest first.
+func (s *SpaceSaving[T]) TopK(k int) []Counter[T] {
+	all := make([]Counter[T], len(s.counters.counters))
+	for i, c := range s.counters.counters {
+		all[i] = *c
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].Count > all[j].Count
+	})
+
+	if k < 0 {
+		k = 0
+	}
+	if k < len(all) {
+		all = all[:k]
+	}
+
+	return all
+}
@@ -0,0 +1,85 @@
+package stream
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSpaceSavingHeavyHitters(t *testing.T) {
+	r := rand.New(rand.NewSource(242))
+	zipf := rand.NewZipf(r, 1.5, 1, 9999)
+
+	const n = 100000
+	s := NewSpaceSaving[uint64](50)
+	truth := map[uint64]int{}
+	i := 0
+	for i < n {
+		item := zipf.Uint64()
+		truth[item]++
+		s.Observe(item)
+		i++
+	}
+
+	top := s.TopK(5)
+	if len(top) != 5 {
+		t.Fatalf("TopK(5) returned %d counters", len(top))
+	}
+
+	// With 50 counters no estimate is off by more than n/50, so the five
+	// heaviest items, far above that, must all make the list.
+	tracked := map[uint64]Counter[uint64]{}
+	for _, c := range s.TopK(50) {
+		tracked[c.Item] = c
+		if c.Count < truth[c.Item] || c.Count-c.Error > truth[c.Item] {
+			t.Errorf("item %d: count %d with error %d, true count %d", c.Item, c.Count, c.Error, truth[c.Item])
+		}
+		if c.Error > n/50 {
+			t.Errorf("item %d: error %d exceeds n/capacity = %d", c.Item, c.Error, n/50)
+		}
+	}
+	for _, c := range top {
+		if c.Item > 5 {
+			t.Errorf("TopK(5) includes %d, want only the five heaviest ranks", c.Item)
+		}
+	}
+
+	i = 1
+	for i < len(top) {
+		if top[i].Count > top[i-1].Count {
+			t.Errorf("TopK not sorted by count: %v", top)
+		}
+		i++
+	}
+}
+
+func TestSpaceSavingDropsRareItems(t *testing.T) {
+	s := NewSpaceSaving[string](2)
+	s.Observe("rare")
+	for _, item := range []string{"a", "b", "a", "b", "a", "b"} {
+		s.Observe(item)
+	}
+
+	for _, c := range s.TopK(10) {
+		if c.Item == "rare" {
+			t.Errorf("rare item still tracked: %+v", c)
+		}
+	}
+	if got := len(s.TopK(10)); got != 2 {
+		t.Errorf("tracking %d counters, capacity 2", got)
+	}
+}
+
+func TestSpaceSavingExactUnderCapacity(t *testing.T) {
+	s := NewSpaceSaving[int](10)
+	for _, item := range []int{1, 2, 2, 3, 3, 3} {
+		s.Observe(item)
+	}
+
+	top := s.TopK(2)
+	if len(top) != 2 || top[0] != (Counter[int]{Item: 3, Count: 3}) || top[1] != (Counter[int]{Item: 2, Count: 2}) {
+		t.Errorf("TopK(2) = %+v, want exact counts for 3 and 2", top)
+	}
+	if got := s.TopK(-1); len(got) != 0 {
+		t.Errorf("TopK(-1) = %v, want empty", got)
+	}
+}
@@ -0,0 +1,104 @@
+package stringalgo
+
+import (
+	"math"
+	"unicode"
+)
+
+const (
+	SCORE_MATCH       = 16
+	BONUS_START       = 12
+	BONUS_BOUNDARY    = 10
+	BONUS_CAMEL_CASE  = 8
+	BONUS_CONSECUTIVE = 12
+	PENALTY_GAP       = 2
+)
+
+func isSeparator(r rune) bool {
+	return unicode.IsSpace(r) || r == '_' || r == '-' || r == '/' || r == '.' || r == ':'
+}
+
+func positionBonus(text []rune, j int) int {
+	if j == 0 {
+		return BONUS_START
+	}
+
+	prev, cur := text[j-1], text[j]
+	if isSeparator(prev) {
+		return BONUS_BOUNDARY
+	}
+	if unicode.IsLower(prev) && unicode.IsUpper(cur) {
+		return BONUS_CAMEL_CASE
+	}
+	return 0
+}
+
+// FuzzyScore reports whether pattern is a case-insensitive subsequence of text
+// and, if so, the score of its best alignment. Matches earn a bonus at the
+// start of text, after a separator, on a camelCase hump and when they directly
+// follow the previous match, while every skipped character between two matches
+// costs a penalty.
+func FuzzyScore(pattern, text string) (score int, matched bool) {
+	p := []rune(pattern)
+	t := []rune(text)
+	if len(p) == 0 {
+		return 0, true
+	}
+	if len(p) > len(t) {
+		return 0, false
+	}
+
+	const none = math.MinInt / 2
+	prev := make([]int, len(t))
+	cur := make([]int, len(t))
+
+	i := 0
+	for i < len(p) {
+		pc := unicode.ToLower(p[i])
+		// gapBest tracks max(prev[k] + PENALTY_GAP*(k+1)) over k <= j-2, so
+		// that a match at j after k pays PENALTY_GAP for each of the j-k-1
+		// characters skipped.
+		gapBest := none
+
+		j := 0
+		for j < len(t) {
+			if j >= 2 && prev[j-2] > none {
+				gapBest = max(gapBest, prev[j-2]+PENALTY_GAP*(j-1))
+			}
+
+			cur[j] = none
+			if unicode.ToLower(t[j]) == pc {
+				base := SCORE_MATCH + positionBonus(t, j)
+
+				if i == 0 {
+					cur[j] = base
+				} else {
+					best := none
+					if j >= 1 && prev[j-1] > none {
+						best = prev[j-1] + BONUS_CONSECUTIVE
+					}
+					if gapBest > none {
+						best = max(best, gapBest-PENALTY_GAP*j)
+					}
+					if best > none {
+						cur[j] = best + base
+					}
+				}
+			}
+			j++
+		}
+
+		prev, cur = cur, prev
+		i++
+	}
+
+	score = none
+	for _, s := range prev {
+		score = max(score, s)
+	}
+	if score == none {
+		return 0, false
+	}
+
+	return score, true
+}
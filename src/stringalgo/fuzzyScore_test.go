@@ -0,0 +1,59 @@
+package stringalgo
+
+import "testing"
+
+func mustScore(t *testing.T, pattern, text string) int {
+	t.Helper()
+
+	score, matched := FuzzyScore(pattern, text)
+	if !matched {
+		t.Fatalf("FuzzyScore(%q, %q) did not match", pattern, text)
+	}
+	return score
+}
+
+func TestFuzzyScoreConsecutiveBeatsScattered(t *testing.T) {
+	consecutive := mustScore(t, "abc", "xabcx")
+	scattered := mustScore(t, "abc", "xaxbxcx")
+	if consecutive <= scattered {
+		t.Errorf("consecutive score %d not above scattered %d", consecutive, scattered)
+	}
+}
+
+func TestFuzzyScoreBoundaries(t *testing.T) {
+	camel := mustScore(t, "fb", "fooBar")
+	middle := mustScore(t, "fb", "foobar")
+	if camel <= middle {
+		t.Errorf("camelCase score %d not above mid-word %d", camel, middle)
+	}
+
+	separated := mustScore(t, "fb", "foo_bar")
+	inner := mustScore(t, "fb", "foo_xbar")
+	if separated <= inner {
+		t.Errorf("boundary score %d not above inner %d", separated, inner)
+	}
+
+	start := mustScore(t, "b", "bar")
+	later := mustScore(t, "b", "abar")
+	if start <= later {
+		t.Errorf("start score %d not above later %d", start, later)
+	}
+}
+
+func TestFuzzyScoreCaseAndEmpty(t *testing.T) {
+	mustScore(t, "GIT", "git commit")
+	mustScore(t, "", "anything")
+}
+
+func TestFuzzyScoreNoMatch(t *testing.T) {
+	cases := [][2]string{
+		{"abc", "acb"},
+		{"abc", "ab"},
+		{"x", ""},
+	}
+	for _, c := range cases {
+		if score, matched := FuzzyScore(c[0], c[1]); matched {
+			t.Errorf("FuzzyScore(%q, %q) = %d, true, want no match", c[0], c[1], score)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package stringalgo
+
+import (
+	"math/bits"
+	"math/rand"
+)
+
+// HASH_MODULUS is the Mersenne prime 2^61-1 that the rolling hashes reduce by.
+const HASH_MODULUS = 1<<61 - 1
+
+// addMod returns (x + y) mod HASH_MODULUS for x, y < HASH_MODULUS.
+func addMod(x, y uint64) uint64 {
+	s := x + y
+	if s >= HASH_MODULUS {
+		s -= HASH_MODULUS
+	}
+	return s
+}
+
+// mulMod returns x*y mod HASH_MODULUS for x, y < HASH_MODULUS. Since 2^61 is
+// 1 modulo the prime, the high bits of the 122-bit product fold onto the low
+// 61.
+func mulMod(x, y uint64) uint64 {
+	hi, lo := bits.Mul64(x, y)
+	return addMod(hi<<3|lo>>61, lo&HASH_MODULUS)
+}
+
+// commonOfLength returns a substring of length l > 0 shared by a and b, if any.
+// Hashes of a's windows are only a filter: equal hashes can come from
+// different strings, so each candidate is compared byte by byte before it is
+// accepted, which keeps the answer exact whatever the collision rate.
+func commonOfLength(a, b string, l int, base uint64) (string, bool) {
+	var power uint64 = 1
+	i := 1
+	for i < l {
+		power = mulMod(power, base)
+		i++
+	}
+
+	windows := func(s string, visit func(start int, hash uint64) bool) bool {
+		var hash uint64
+		i := 0
+		for i < len(s) {
+			if i >= l {
+				hash = addMod(hash, HASH_MODULUS-mulMod(uint64(s[i-l]), power))
+			}
+			hash = addMod(mulMod(hash, base), uint64(s[i]))
+			if i >= l-1 && visit(i-l+1, hash) {
+				return true
+			}
+			i++
+		}
+		return false
+	}
+
+	starts := map[uint64][]int{}
+	windows(a, func(start int, hash uint64) bool {
+		starts[hash] = append(starts[hash], start)
+		return false
+	})
+
+	var found string
+	ok := windows(b, func(start int, hash uint64) bool {
+		candidate := b[start : start+l]
+		for _, s := range starts[hash] {
+			if a[s:s+l] == candidate {
+				found = candidate
+				return true
+			}
+		}
+		return false
+	})
+
+	return found, ok
+}
+
+// LongestCommonSubstringHash binary-searches the length of the longest common
+// substring, testing each length with rolling hashes, for O((n + m) log
+// min(n, m)) expected time. Among several longest answers it returns the one
+// that starts first in b. Every hash match is verified, so collisions never
+// make the answer wrong, only slower; to keep them rare on any input, even
+// Thue–Morse strings that defeat hashing modulo 2^64, the hashes are
+// polynomials modulo the prime HASH_MODULUS in a base drawn at random on each
+// call.
+func LongestCommonSubstringHash(a, b string) string {
+	base := 256 + rand.Uint64()%(HASH_MODULUS-256)
+	best := ""
+	lo, hi := 1, min(len(a), len(b))
+
+	for lo <= hi {
+		mid := lo + (hi-lo)/2
+		if s, ok := commonOfLength(a, b, mid, base); ok {
+			best = s
+			lo = mid + 1
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	return best
+}
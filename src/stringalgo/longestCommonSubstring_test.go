@@ -0,0 +1,124 @@
+package stringalgo
+
+import (
+	"math/big"
+	"math/bits"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// dpLongestCommonSubstring is the O(nm) reference. Ties go to the match that
+// starts first in b, the same rule LongestCommonSubstringHash documents.
+func dpLongestCommonSubstring(a, b string) string {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	bestLen, bestStart := 0, 0
+
+	i := 1
+	for i <= len(a) {
+		j := 1
+		for j <= len(b) {
+			if a[i-1] == b[j-1] {
+				cur[j] = prev[j-1] + 1
+				start := j - cur[j]
+				if cur[j] > bestLen || (cur[j] == bestLen && start < bestStart) {
+					bestLen, bestStart = cur[j], start
+				}
+			} else {
+				cur[j] = 0
+			}
+			j++
+		}
+		prev, cur = cur, prev
+		i++
+	}
+
+	return b[bestStart : bestStart+bestLen]
+}
+
+func TestLongestCommonSubstringHashKnown(t *testing.T) {
+	cases := []struct {
+		a, b, want string
+	}{
+		{"xabcdey", "zzabcdq", "abcd"},
+		{"banana", "ananas", "anana"},
+		{"abc", "def", ""},
+		{"", "abc", ""},
+		{"same", "same", "same"},
+		{"ab", "ba", "b"},
+	}
+	for _, c := range cases {
+		if got := LongestCommonSubstringHash(c.a, c.b); got != c.want {
+			t.Errorf("LongestCommonSubstringHash(%q, %q) = %q, want %q", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestLongestCommonSubstringHashRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(261))
+
+	// Hash matches are only candidates: commonOfLength compares every hit
+	// byte by byte, so even a collision can't produce a wrong answer, and the
+	// result has to agree with the DP exactly, tie-break included.
+	trial := 0
+	for trial < 500 {
+		a := randomString(r, r.Intn(40), "abcd"[:1+r.Intn(4)])
+		b := randomString(r, r.Intn(40), "abcd"[:1+r.Intn(4)])
+
+		got := LongestCommonSubstringHash(a, b)
+		if want := dpLongestCommonSubstring(a, b); got != want {
+			t.Fatalf("LongestCommonSubstringHash(%q, %q) = %q, want %q", a, b, got, want)
+		}
+		if !strings.Contains(a, got) || !strings.Contains(b, got) {
+			t.Fatalf("%q is not common to %q and %q", got, a, b)
+		}
+		trial++
+	}
+}
+
+// thueMorse returns the first n letters of the Thue–Morse sequence over a
+// and b, the classic input that makes polynomial hashes modulo 2^64 collide.
+func thueMorse(n int) string {
+	out := make([]byte, n)
+	i := 0
+	for i < n {
+		out[i] = 'a' + byte(bits.OnesCount(uint(i))%2)
+		i++
+	}
+	return string(out)
+}
+
+func TestLongestCommonSubstringHashThueMorse(t *testing.T) {
+	tm := thueMorse(2048)
+	cases := [][2]string{
+		{tm[:1024], tm[1024:]},
+		{tm, strings.Map(func(r rune) rune { return 'a' + 'b' - r }, tm[:1500])},
+	}
+	for _, c := range cases {
+		if got, want := LongestCommonSubstringHash(c[0], c[1]), dpLongestCommonSubstring(c[0], c[1]); got != want {
+			t.Errorf("LongestCommonSubstringHash on Thue–Morse strings: length %d, want %d", len(got), len(want))
+		}
+	}
+}
+
+func TestMulMod(t *testing.T) {
+	r := rand.New(rand.NewSource(261))
+	modulus := new(big.Int).SetUint64(HASH_MODULUS)
+
+	values := []uint64{0, 1, 2, HASH_MODULUS - 1, HASH_MODULUS - 2, 1 << 60}
+	k := 0
+	for k < 200 {
+		values = append(values, r.Uint64()%HASH_MODULUS)
+		k++
+	}
+	for _, x := range values {
+		for _, y := range values[:20] {
+			want := new(big.Int).Mul(new(big.Int).SetUint64(x), new(big.Int).SetUint64(y))
+			want.Mod(want, modulus)
+			if got := mulMod(x, y); got != want.Uint64() {
+				t.Fatalf("mulMod(%d, %d) = %d, want %d", x, y, got, want.Uint64())
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package stringalgo
+
+type state struct {
+	length int
+	link   int
+	next   map[byte]int
+}
+
+type SuffixAutomaton struct {
+	states []state
+	last   int
+}
+
+func NewSuffixAutomaton(s string) *SuffixAutomaton {
+	sa := &SuffixAutomaton{
+		states: make([]state, 1, 2*len(s)+1),
+	}
+	sa.states[0] = state{length: 0, link: -1, next: map[byte]int{}}
+
+	i := 0
+	for i < len(s) {
+		sa.extend(s[i])
+		i++
+	}
+
+	return sa
+}
+
+func (sa *SuffixAutomaton) extend(c byte) {
+	cur := len(sa.states)
+	sa.states = append(sa.states, state{length: sa.states[sa.last].length + 1, link: -1, next: map[byte]int{}})
+
+	p := sa.last
+	for p != -1 {
+		if _, ok := sa.states[p].next[c]; ok {
+			break
+		}
+		sa.states[p].next[c] = cur
+		p = sa.states[p].link
+	}
+
+	if p == -1 {
+		sa.states[cur].link = 0
+	} else {
+		q := sa.states[p].next[c]
+		if sa.states[p].length+1 == sa.states[q].length {
+			sa.states[cur].link = q
+		} else {
+			clone := len(sa.states)
+			next := make(map[byte]int, len(sa.states[q].next))
+			for k, v := range sa.states[q].next {
+				next[k] = v
+			}
+			sa.states = append(sa.states, state{length: sa.states[p].length + 1, link: sa.states[q].link, next: next})
+
+			for p != -1 && sa.states[p].next[c] == q {
+				sa.states[p].next[c] = clone
+				p = sa.states[p].link
+			}
+
+			sa.states[q].link = clone
+			sa.states[cur].link = clone
+		}
+	}
+
+	sa.last = cur
+}
+
+func (sa *SuffixAutomaton) Contains(substring string) bool {
+	current := 0
+
+	i := 0
+	for i < len(substring) {
+		next, ok := sa.states[current].next[substring[i]]
+		if !ok {
+			return false
+		}
+		current = next
+		i++
+	}
+
+	return true
+}
+
+// Every state other than the root contributes the substrings whose lengths
+// lie between its suffix link's length (exclusive) and its own (inclusive).
+func (sa *SuffixAutomaton) CountDistinctSubstrings() int {
+	count := 0
+
+	i := 1
+	for i < len(sa.states) {
+		count += sa.states[i].length - sa.states[sa.states[i].link].length
+		i++
+	}
+
+	return count
+}
@@ -0,0 +1,45 @@
+package stringalgo
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func randomString(r *rand.Rand, n int, alphabet string) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = alphabet[r.Intn(len(alphabet))]
+	}
+	return string(b)
+}
+
+func TestSuffixAutomatonContains(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for it := 0; it < 200; it++ {
+		s := randomString(r, r.Intn(20), "abc")
+		sa := NewSuffixAutomaton(s)
+		for q := 0; q < 50; q++ {
+			sub := randomString(r, r.Intn(6), "abcd")
+			if got, want := sa.Contains(sub), strings.Contains(s, sub); got != want {
+				t.Fatalf("Contains(%q) on %q = %v, want %v", sub, s, got, want)
+			}
+		}
+	}
+}
+
+func TestSuffixAutomatonCountDistinctSubstrings(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for it := 0; it < 200; it++ {
+		s := randomString(r, r.Intn(15), "ab")
+		distinct := map[string]bool{}
+		for i := 0; i < len(s); i++ {
+			for j := i + 1; j <= len(s); j++ {
+				distinct[s[i:j]] = true
+			}
+		}
+		if got := NewSuffixAutomaton(s).CountDistinctSubstrings(); got != len(distinct) {
+			t.Fatalf("CountDistinctSubstrings(%q) = %d, want %d", s, got, len(distinct))
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package tree
+
+// CentroidDecomposition is the tree of centroids of an undirected tree: the
+// root is a centroid of the whole tree, and the children of a centroid are the
+// centroids of the pieces left after removing it, each at most half the size.
+// Any path in the original tree passes through the shallowest centroid on it,
+// which is what makes path queries over the decomposition cheap.
+type CentroidDecomposition struct {
+	Root     int
+	Parent   map[int]int
+	Children map[int][]int
+	Level    map[int]int
+	adj      map[int][]Edge
+}
+
+func NewCentroidDecomposition(adj map[int][]Edge) *CentroidDecomposition {
+	cd := &CentroidDecomposition{
+		Root:     -1,
+		Parent:   map[int]int{},
+		Children: map[int][]int{},
+		Level:    map[int]int{},
+		adj:      adj,
+	}
+
+	start, first := 0, true
+	for v := range adj {
+		if first || v < start {
+			start, first = v, false
+		}
+	}
+	if !first {
+		cd.Root = cd.build(start, -1, 0)
+	}
+
+	return cd
+}
+
+// component lists the vertices reachable from start without passing through
+// an already chosen centroid, parents before children, with each vertex's
+// parent in that traversal.
+func (cd *CentroidDecomposition) component(start int) (order []int, parent map[int]int) {
+	parent = map[int]int{start: -1}
+	stack := []int{start}
+
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		order = append(order, v)
+
+		for _, e := range cd.adj[v] {
+			if _, removed := cd.Level[e.To]; removed || e.To == parent[v] {
+				continue
+			}
+			parent[e.To] = v
+			stack = append(stack, e.To)
+		}
+	}
+
+	return order, parent
+}
+
+func (cd *CentroidDecomposition) build(entry, parentCentroid, level int) int {
+	order, parent := cd.component(entry)
+	total := len(order)
+
+	size := map[int]int{}
+	heaviest := map[int]int{}
+	i := len(order) - 1
+	for i >= 0 {
+		v := order[i]
+		size[v]++
+		if p := parent[v]; p != -1 {
+			size[p] += size[v]
+			heaviest[p] = max(heaviest[p], size[v])
+		}
+		i--
+	}
+
+	centroid := entry
+	for _, v := range order {
+		if max(heaviest[v], total-size[v]) <= total/2 {
+			centroid = v
+			break
+		}
+	}
+
+	cd.Level[centroid] = level
+	if parentCentroid != -1 {
+		cd.Parent[centroid] = parentCentroid
+		cd.Children[parentCentroid] = append(cd.Children[parentCentroid], centroid)
+	}
+
+	for _, e := range cd.adj[centroid] {
+		if _, removed := cd.Level[e.To]; !removed {
+			cd.build(e.To, centroid, level+1)
+		}
+	}
+
+	return centroid
+}
+
+// CountPairsAtDistance counts unordered pairs of distinct vertices whose path
+// length, summing edge weights, is exactly k. Every path is counted at the
+// shallowest centroid on it by combining distances from that centroid across
+// its different subtrees, in O(n log n) overall.
+func (cd *CentroidDecomposition) CountPairsAtDistance(k int) int {
+	pairs := 0
+
+	for c, level := range cd.Level {
+		counts := map[int]int{0: 1}
+
+		for _, e := range cd.adj[c] {
+			if l, ok := cd.Level[e.To]; ok && l <= level {
+				continue
+			}
+
+			order, distance := cd.componentBelow(e.To, c, level)
+			for _, v := range order {
+				pairs += counts[k-distance[v]-e.Weight]
+			}
+			for _, v := range order {
+				counts[distance[v]+e.Weight]++
+			}
+		}
+	}
+
+	return pairs
+}
+
+// componentBelow walks from start, away from the centroid at from, over
+// vertices decomposed deeper than level, which are exactly those in the
+// centroid's piece of the tree. Distances are measured from start.
+func (cd *CentroidDecomposition) componentBelow(start, from, level int) (order []int, distance map[int]int) {
+	parent := map[int]int{start: from}
+	distance = map[int]int{start: 0}
+	stack := []int{start}
+
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		order = append(order, v)
+
+		for _, e := range cd.adj[v] {
+			if l := cd.Level[e.To]; l <= level || e.To == parent[v] {
+				continue
+			}
+			parent[e.To] = v
+			distance[e.To] = distance[v] + e.Weight
+			stack = append(stack, e.To)
+		}
+	}
+
+	return order, distance
+}
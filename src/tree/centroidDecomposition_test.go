@@ -0,0 +1,102 @@
+package tree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func randomWeightedTree(r *rand.Rand, n, maxWeight int) map[int][]Edge {
+	edges := randomTree(r, n)
+	for i := range edges {
+		edges[i].Weight = 1 + r.Intn(maxWeight)
+	}
+	adj := adjacency(edges)
+	if n == 1 {
+		adj[0] = nil
+	}
+	return adj
+}
+
+func brutePairsAtDistance(adj map[int][]Edge, k int) int {
+	count := 0
+	for u := range adj {
+		for v := range adj {
+			if u < v && distance(adj, u, v) == k {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func decompositionSize(cd *CentroidDecomposition, c int) int {
+	size := 1
+	for _, child := range cd.Children[c] {
+		size += decompositionSize(cd, child)
+	}
+	return size
+}
+
+func TestCentroidDecompositionStructure(t *testing.T) {
+	r := rand.New(rand.NewSource(251))
+
+	trial := 0
+	for trial < 100 {
+		n := 1 + r.Intn(60)
+		adj := randomWeightedTree(r, n, 1)
+		cd := NewCentroidDecomposition(adj)
+
+		if got := decompositionSize(cd, cd.Root); got != n {
+			t.Fatalf("decomposition covers %d of %d vertices", got, n)
+		}
+		if _, ok := cd.Parent[cd.Root]; ok && cd.Parent[cd.Root] != -1 {
+			t.Fatalf("root %d has parent %d", cd.Root, cd.Parent[cd.Root])
+		}
+
+		maxLevel := 0
+		for v := range adj {
+			size := decompositionSize(cd, v)
+			for _, child := range cd.Children[v] {
+				if cs := decompositionSize(cd, child); 2*cs > size {
+					t.Fatalf("centroid %d piece of %d has a child piece of %d", v, size, cs)
+				}
+				if cd.Parent[child] != v || cd.Level[child] != cd.Level[v]+1 {
+					t.Fatalf("child %d of %d has parent %d and level %d", child, v, cd.Parent[child], cd.Level[child])
+				}
+			}
+			if cd.Level[v] > maxLevel {
+				maxLevel = cd.Level[v]
+			}
+		}
+		if 1<<maxLevel > n {
+			t.Fatalf("depth %d too large for %d vertices", maxLevel, n)
+		}
+		trial++
+	}
+}
+
+func TestCountPairsAtDistance(t *testing.T) {
+	r := rand.New(rand.NewSource(2510))
+
+	trial := 0
+	for trial < 100 {
+		n := 1 + r.Intn(40)
+		adj := randomWeightedTree(r, n, 1+trial%3)
+		cd := NewCentroidDecomposition(adj)
+
+		for _, k := range []int{0, 1, 2, 3, 5, 8} {
+			want := brutePairsAtDistance(adj, k)
+			if got := cd.CountPairsAtDistance(k); got != want {
+				t.Fatalf("trial %d, k=%d: CountPairsAtDistance = %d, want %d", trial, k, got, want)
+			}
+		}
+		trial++
+	}
+}
+
+func TestCentroidDecompositionEmpty(t *testing.T) {
+	cd := NewCentroidDecomposition(map[int][]Edge{})
+	if cd.Root != -1 || cd.CountPairsAtDistance(1) != 0 {
+		t.Errorf("empty tree: Root = %d, pairs = %d, want -1 and 0", cd.Root, cd.CountPairsAtDistance(1))
+	}
+}
@@ -0,0 +1,49 @@
+package tree
+
+func farthest(adj map[int][]Edge, start int) (int, int) {
+	distance := map[int]int{start: 0}
+	stack := []int{start}
+	far := start
+
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if distance[v] > distance[far] {
+			far = v
+		}
+
+		for _, e := range adj[v] {
+			if _, seen := distance[e.To]; !seen {
+				distance[e.To] = distance[v] + e.Weight
+				stack = append(stack, e.To)
+			}
+		}
+	}
+
+	return far, distance[far]
+}
+
+// Diameter finds the longest path in a tree given as an undirected adjacency
+// map with non-negative weights: the vertex u farthest from an arbitrary start
+// is one end of a longest path, and the vertex farthest from u is the other.
+// A single vertex has diameter 0 with both endpoints equal to it.
+func Diameter(adj map[int][]Edge) (length int, endpoints [2]int) {
+	if len(adj) == 0 {
+		return 0, endpoints
+	}
+
+	start := 0
+	first := true
+	for v := range adj {
+		if first || v < start {
+			start = v
+			first = false
+		}
+	}
+
+	u, _ := farthest(adj, start)
+	v, length := farthest(adj, u)
+
+	return length, [2]int{u, v}
+}
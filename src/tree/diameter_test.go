@@ -0,0 +1,78 @@
+package tree
+
+import "testing"
+
+func adjacency(edges []Edge) map[int][]Edge {
+	adj := map[int][]Edge{}
+	for _, e := range edges {
+		adj[e.From] = append(adj[e.From], e)
+		adj[e.To] = append(adj[e.To], Edge{From: e.To, To: e.From, Weight: e.Weight})
+	}
+	return adj
+}
+
+func distance(adj map[int][]Edge, from, to int) int {
+	dist := map[int]int{from: 0}
+	stack := []int{from}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, e := range adj[v] {
+			if _, ok := dist[e.To]; !ok {
+				dist[e.To] = dist[v] + e.Weight
+				stack = append(stack, e.To)
+			}
+		}
+	}
+	return dist[to]
+}
+
+func TestDiameterKnown(t *testing.T) {
+	//        0
+	//      3/ \1
+	//      1   2
+	//    2/ \4   \7
+	//    3   4    5
+	adj := adjacency([]Edge{
+		{From: 0, To: 1, Weight: 3},
+		{From: 0, To: 2, Weight: 1},
+		{From: 1, To: 3, Weight: 2},
+		{From: 1, To: 4, Weight: 4},
+		{From: 2, To: 5, Weight: 7},
+	})
+
+	length, ends := Diameter(adj)
+	if length != 15 {
+		t.Errorf("Diameter = %d, want 15", length)
+	}
+	if !(ends == [2]int{4, 5} || ends == [2]int{5, 4}) {
+		t.Errorf("endpoints = %v, want 4 and 5", ends)
+	}
+	if d := distance(adj, ends[0], ends[1]); d != length {
+		t.Errorf("endpoints are %d apart, reported %d", d, length)
+	}
+}
+
+func TestDiameterPath(t *testing.T) {
+	var edges []Edge
+	v := 1
+	for v < 8 {
+		edges = append(edges, Edge{From: v - 1, To: v, Weight: 1})
+		v++
+	}
+
+	length, ends := Diameter(adjacency(edges))
+	if length != 7 {
+		t.Errorf("Diameter = %d, want 7 edges", length)
+	}
+	if !(ends == [2]int{0, 7} || ends == [2]int{7, 0}) {
+		t.Errorf("endpoints = %v, want 0 and 7", ends)
+	}
+}
+
+func TestDiameterSingleVertex(t *testing.T) {
+	length, ends := Diameter(map[int][]Edge{4: nil})
+	if length != 0 || ends != [2]int{4, 4} {
+		t.Errorf("Diameter = %d, %v, want 0, [4 4]", length, ends)
+	}
+}
@@ -0,0 +1,216 @@
+package tree
+
+// LinkCut maintains a forest over vertices 0..n-1 under edge insertions and
+// deletions, with amortized O(log n) operations. Each vertex carries a value
+// and PathAggregate folds the values along a path with combine, which must be
+// associative and commutative (sum, min, max, xor) because paths are reversed
+// internally when the tree is rerooted.
+type LinkCut[T any] struct {
+	combine  func(a, b T) T
+	value    []T
+	total    []T
+	parent   []int
+	children [][2]int
+	reversed []bool
+}
+
+func NewLinkCut[T any](values []T, combine func(a, b T) T) *LinkCut[T] {
+	n := len(values)
+	lc := &LinkCut[T]{
+		combine:  combine,
+		value:    make([]T, n),
+		total:    make([]T, n),
+		parent:   make([]int, n),
+		children: make([][2]int, n),
+		reversed: make([]bool, n),
+	}
+	copy(lc.value, values)
+	copy(lc.total, values)
+
+	i := 0
+	for i < n {
+		lc.parent[i] = -1
+		lc.children[i] = [2]int{-1, -1}
+		i++
+	}
+
+	return lc
+}
+
+// isRoot reports whether x is the root of its splay tree, i.e. its parent
+// pointer, if any, is a path-parent link rather than a splay edge.
+func (lc *LinkCut[T]) isRoot(x int) bool {
+	p := lc.parent[x]
+	return p == -1 || (lc.children[p][0] != x && lc.children[p][1] != x)
+}
+
+func (lc *LinkCut[T]) update(x int) {
+	total := lc.value[x]
+	if l := lc.children[x][0]; l != -1 {
+		total = lc.combine(lc.total[l], total)
+	}
+	if r := lc.children[x][1]; r != -1 {
+		total = lc.combine(total, lc.total[r])
+	}
+	lc.total[x] = total
+}
+
+func (lc *LinkCut[T]) push(x int) {
+	if !lc.reversed[x] {
+		return
+	}
+
+	lc.children[x][0], lc.children[x][1] = lc.children[x][1], lc.children[x][0]
+	for _, c := range lc.children[x] {
+		if c != -1 {
+			lc.reversed[c] = !lc.reversed[c]
+		}
+	}
+	lc.reversed[x] = false
+}
+
+func (lc *LinkCut[T]) rotate(x int) {
+	y := lc.parent[x]
+	z := lc.parent[y]
+	side := 0
+	if lc.children[y][1] == x {
+		side = 1
+	}
+
+	if !lc.isRoot(y) {
+		if lc.children[z][0] == y {
+			lc.children[z][0] = x
+		} else {
+			lc.children[z][1] = x
+		}
+	}
+	lc.parent[x] = z
+
+	inner := lc.children[x][1-side]
+	lc.children[y][side] = inner
+	if inner != -1 {
+		lc.parent[inner] = y
+	}
+
+	lc.children[x][1-side] = y
+	lc.parent[y] = x
+
+	lc.update(y)
+	lc.update(x)
+}
+
+func (lc *LinkCut[T]) splay(x int) {
+	path := []int{x}
+	y := x
+	for !lc.isRoot(y) {
+		y = lc.parent[y]
+		path = append(path, y)
+	}
+	i := len(path) - 1
+	for i >= 0 {
+		lc.push(path[i])
+		i--
+	}
+
+	for !lc.isRoot(x) {
+		y := lc.parent[x]
+		if !lc.isRoot(y) {
+			z := lc.parent[y]
+			if (lc.children[y][0] == x) == (lc.children[z][0] == y) {
+				lc.rotate(y)
+			} else {
+				lc.rotate(x)
+			}
+		}
+		lc.rotate(x)
+	}
+}
+
+// access makes the path from x to its tree's root preferred, leaving x at the
+// root of a splay tree that holds exactly that path.
+func (lc *LinkCut[T]) access(x int) {
+	last := -1
+	y := x
+	for y != -1 {
+		lc.splay(y)
+		lc.children[y][1] = last
+		lc.update(y)
+		last = y
+		y = lc.parent[y]
+	}
+	lc.splay(x)
+}
+
+func (lc *LinkCut[T]) makeRoot(x int) {
+	lc.access(x)
+	lc.reversed[x] = !lc.reversed[x]
+}
+
+func (lc *LinkCut[T]) findRoot(x int) int {
+	lc.access(x)
+	lc.push(x)
+	for lc.children[x][0] != -1 {
+		x = lc.children[x][0]
+		lc.push(x)
+	}
+	lc.splay(x)
+	return x
+}
+
+func (lc *LinkCut[T]) Connected(u, v int) bool {
+	return u == v || lc.findRoot(u) == lc.findRoot(v)
+}
+
+// Link adds the edge u-v. It returns false, changing nothing, if u and v are
+// already in the same tree.
+func (lc *LinkCut[T]) Link(u, v int) bool {
+	if lc.Connected(u, v) {
+		return false
+	}
+
+	lc.makeRoot(u)
+	lc.parent[u] = v
+	return true
+}
+
+// Cut removes the edge u-v. It returns false if there is no such edge.
+func (lc *LinkCut[T]) Cut(u, v int) bool {
+	if u == v {
+		return false
+	}
+
+	lc.makeRoot(u)
+	lc.access(v)
+	if lc.children[v][0] != u {
+		return false
+	}
+	lc.push(u)
+	if lc.children[u][1] != -1 {
+		return false
+	}
+
+	lc.children[v][0] = -1
+	lc.parent[u] = -1
+	lc.update(v)
+	return true
+}
+
+// PathAggregate folds the values of every vertex on the path from u to v,
+// both included. It returns false if u and v are not connected.
+func (lc *LinkCut[T]) PathAggregate(u, v int) (T, bool) {
+	if !lc.Connected(u, v) {
+		var zero T
+		return zero, false
+	}
+
+	lc.makeRoot(u)
+	lc.access(v)
+	return lc.total[v], true
+}
+
+// SetValue replaces the value carried by x.
+func (lc *LinkCut[T]) SetValue(x int, value T) {
+	lc.access(x)
+	lc.value[x] = value
+	lc.update(x)
+}
@@ -0,0 +1,128 @@
+package tree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// forest is the reference: an explicit edge set searched from scratch on every
+// query.
+type forest struct {
+	adj    []map[int]bool
+	values []int
+}
+
+func (f *forest) path(u, v int) []int {
+	parent := map[int]int{u: -1}
+	queue := []int{u}
+	for len(queue) > 0 {
+		x := queue[0]
+		queue = queue[1:]
+		for y := range f.adj[x] {
+			if _, seen := parent[y]; !seen {
+				parent[y] = x
+				queue = append(queue, y)
+			}
+		}
+	}
+
+	if _, ok := parent[v]; !ok {
+		return nil
+	}
+	var path []int
+	x := v
+	for x != -1 {
+		path = append(path, x)
+		x = parent[x]
+	}
+	return path
+}
+
+func TestLinkCutAgainstReference(t *testing.T) {
+	r := rand.New(rand.NewSource(256))
+
+	trial := 0
+	for trial < 20 {
+		n := 2 + r.Intn(25)
+		f := &forest{adj: make([]map[int]bool, n), values: make([]int, n)}
+		for i := range f.adj {
+			f.adj[i] = map[int]bool{}
+			f.values[i] = r.Intn(100)
+		}
+		lc := NewLinkCut(append([]int{}, f.values...), func(a, b int) int { return a + b })
+
+		op := 0
+		for op < 400 {
+			u, v := r.Intn(n), r.Intn(n)
+			switch r.Intn(4) {
+			case 0:
+				want := u != v && f.path(u, v) == nil
+				if got := lc.Link(u, v); got != want {
+					t.Fatalf("trial %d op %d: Link(%d, %d) = %v, want %v", trial, op, u, v, got, want)
+				}
+				if want {
+					f.adj[u][v], f.adj[v][u] = true, true
+				}
+			case 1:
+				want := f.adj[u][v]
+				if got := lc.Cut(u, v); got != want {
+					t.Fatalf("trial %d op %d: Cut(%d, %d) = %v, want %v", trial, op, u, v, got, want)
+				}
+				delete(f.adj[u], v)
+				delete(f.adj[v], u)
+			case 2:
+				value := r.Intn(100)
+				f.values[u] = value
+				lc.SetValue(u, value)
+			}
+
+			// Re-check a handful of pairs against the reference after every
+			// operation.
+			check := 0
+			for check < 5 {
+				a, b := r.Intn(n), r.Intn(n)
+				path := f.path(a, b)
+				if got := lc.Connected(a, b); got != (path != nil) {
+					t.Fatalf("trial %d op %d: Connected(%d, %d) = %v, want %v", trial, op, a, b, got, path != nil)
+				}
+
+				want := 0
+				for _, x := range path {
+					want += f.values[x]
+				}
+				got, ok := lc.PathAggregate(a, b)
+				if ok != (path != nil) || (ok && got != want) {
+					t.Fatalf("trial %d op %d: PathAggregate(%d, %d) = %d, %v, want %d, %v", trial, op, a, b, got, ok, want, path != nil)
+				}
+				check++
+			}
+			op++
+		}
+		trial++
+	}
+}
+
+func TestLinkCutMax(t *testing.T) {
+	lc := NewLinkCut([]int{5, 1, 9, 3}, func(a, b int) int { return max(a, b) })
+	lc.Link(0, 1)
+	lc.Link(1, 3)
+	lc.Link(2, 3)
+
+	if got, _ := lc.PathAggregate(0, 3); got != 5 {
+		t.Errorf("max on 0..3 = %d, want 5", got)
+	}
+	if got, _ := lc.PathAggregate(0, 2); got != 9 {
+		t.Errorf("max on 0..2 = %d, want 9", got)
+	}
+
+	lc.Cut(3, 2)
+	if lc.Connected(0, 2) {
+		t.Errorf("0 and 2 still connected after cutting 2-3")
+	}
+	if _, ok := lc.PathAggregate(0, 2); ok {
+		t.Errorf("PathAggregate across trees succeeded")
+	}
+	if got, ok := lc.PathAggregate(1, 1); !ok || got != 1 {
+		t.Errorf("PathAggregate(1, 1) = %d, %v, want 1", got, ok)
+	}
+}
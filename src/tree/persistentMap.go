@@ -0,0 +1,220 @@
+package tree
+
+type mapNode[K comparable, V any] struct {
+	red         bool
+	key         K
+	value       V
+	left, right *mapNode[K, V]
+}
+
+// PersistentMap is an immutable ordered map backed by a functional red-black
+// tree. Insert and Delete return a new map and leave the receiver untouched;
+// the two share every subtree off the O(log n) path that was rebuilt, so old
+// versions stay usable as cheap snapshots. Insertion follows Okasaki and
+// deletion follows Kahrs.
+type PersistentMap[K comparable, V any] struct {
+	root *mapNode[K, V]
+	size int
+	less func(a, b K) bool
+}
+
+func NewPersistentMap[K comparable, V any](less func(a, b K) bool) *PersistentMap[K, V] {
+	return &PersistentMap[K, V]{less: less}
+}
+
+func (m *PersistentMap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *PersistentMap[K, V]) Get(key K) (V, bool) {
+	n := m.root
+	for n != nil {
+		switch {
+		case m.less(key, n.key):
+			n = n.left
+		case m.less(n.key, key):
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Range calls fn on each entry in ascending key order until fn returns false.
+func (m *PersistentMap[K, V]) Range(fn func(key K, value V) bool) {
+	var stack []*mapNode[K, V]
+	n := m.root
+	for n != nil || len(stack) > 0 {
+		for n != nil {
+			stack = append(stack, n)
+			n = n.left
+		}
+
+		n = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !fn(n.key, n.value) {
+			return
+		}
+		n = n.right
+	}
+}
+
+// Insert returns a map in which key maps to value.
+func (m *PersistentMap[K, V]) Insert(key K, value V) *PersistentMap[K, V] {
+	_, exists := m.Get(key)
+	size := m.size
+	if !exists {
+		size++
+	}
+
+	entry := &mapNode[K, V]{key: key, value: value}
+	return &PersistentMap[K, V]{root: blacken(m.insert(m.root, entry)), size: size, less: m.less}
+}
+
+// Delete returns a map without key. If key is absent the receiver itself is
+// returned.
+func (m *PersistentMap[K, V]) Delete(key K) *PersistentMap[K, V] {
+	if _, ok := m.Get(key); !ok {
+		return m
+	}
+
+	return &PersistentMap[K, V]{root: blacken(m.delete(m.root, key)), size: m.size - 1, less: m.less}
+}
+
+func isRed[K comparable, V any](n *mapNode[K, V]) bool {
+	return n != nil && n.red
+}
+
+func isBlack[K comparable, V any](n *mapNode[K, V]) bool {
+	return n != nil && !n.red
+}
+
+// newMapNode builds a new node holding the entry of pivot.
+func newMapNode[K comparable, V any](red bool, left, pivot, right *mapNode[K, V]) *mapNode[K, V] {
+	return &mapNode[K, V]{red: red, key: pivot.key, value: pivot.value, left: left, right: right}
+}
+
+func blacken[K comparable, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	if isRed(n) {
+		return newMapNode(false, n.left, n, n.right)
+	}
+	return n
+}
+
+// redden turns a black node red, lowering its black height by one.
+func redden[K comparable, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	return newMapNode(true, n.left, n, n.right)
+}
+
+// balance builds a black node from left, pivot and right, first repairing a
+// red node with a red child directly below it.
+func balance[K comparable, V any](left, pivot, right *mapNode[K, V]) *mapNode[K, V] {
+	switch {
+	case isRed(left) && isRed(right):
+		return newMapNode(true, blacken(left), pivot, blacken(right))
+	case isRed(left) && isRed(left.left):
+		return newMapNode(true, blacken(left.left), left, newMapNode(false, left.right, pivot, right))
+	case isRed(left) && isRed(left.right):
+		return newMapNode(true, newMapNode(false, left.left, left, left.right.left), left.right, newMapNode(false, left.right.right, pivot, right))
+	case isRed(right) && isRed(right.right):
+		return newMapNode(true, newMapNode(false, left, pivot, right.left), right, blacken(right.right))
+	case isRed(right) && isRed(right.left):
+		return newMapNode(true, newMapNode(false, left, pivot, right.left.left), right.left, newMapNode(false, right.left.right, right, right.right))
+	}
+	return newMapNode(false, left, pivot, right)
+}
+
+func (m *PersistentMap[K, V]) insert(n, entry *mapNode[K, V]) *mapNode[K, V] {
+	if n == nil {
+		return newMapNode(true, nil, entry, nil)
+	}
+
+	switch {
+	case m.less(entry.key, n.key):
+		if n.red {
+			return newMapNode(true, m.insert(n.left, entry), n, n.right)
+		}
+		return balance(m.insert(n.left, entry), n, n.right)
+	case m.less(n.key, entry.key):
+		if n.red {
+			return newMapNode(true, n.left, n, m.insert(n.right, entry))
+		}
+		return balance(n.left, n, m.insert(n.right, entry))
+	}
+	return newMapNode(n.red, n.left, entry, n.right)
+}
+
+// delete removes key, which must be present, possibly leaving a red root or
+// a subtree one black level shorter for the caller to repair.
+func (m *PersistentMap[K, V]) delete(n *mapNode[K, V], key K) *mapNode[K, V] {
+	switch {
+	case m.less(key, n.key):
+		if isBlack(n.left) {
+			return balanceLeft(m.delete(n.left, key), n, n.right)
+		}
+		return newMapNode(true, m.delete(n.left, key), n, n.right)
+	case m.less(n.key, key):
+		if isBlack(n.right) {
+			return balanceRight(n.left, n, m.delete(n.right, key))
+		}
+		return newMapNode(true, n.left, n, m.delete(n.right, key))
+	}
+	return fuse(n.left, n.right)
+}
+
+// balanceLeft rebuilds a node whose left subtree has lost a black level.
+func balanceLeft[K comparable, V any](left, pivot, right *mapNode[K, V]) *mapNode[K, V] {
+	switch {
+	case isRed(left):
+		return newMapNode(true, blacken(left), pivot, right)
+	case isBlack(right):
+		return balance(left, pivot, redden(right))
+	case isRed(right) && isBlack(right.left):
+		return newMapNode(true, newMapNode(false, left, pivot, right.left.left), right.left, balance(right.left.right, right, redden(right.right)))
+	}
+	panic("tree: red-black invariant violated")
+}
+
+// balanceRight rebuilds a node whose right subtree has lost a black level.
+func balanceRight[K comparable, V any](left, pivot, right *mapNode[K, V]) *mapNode[K, V] {
+	switch {
+	case isRed(right):
+		return newMapNode(true, left, pivot, blacken(right))
+	case isBlack(left):
+		return balance(redden(left), pivot, right)
+	case isRed(left) && isBlack(left.right):
+		return newMapNode(true, balance(redden(left.left), left, left.right.left), left.right, newMapNode(false, left.right.right, pivot, right))
+	}
+	panic("tree: red-black invariant violated")
+}
+
+// fuse joins the two subtrees of a deleted node, every key of a being less
+// than every key of b.
+func fuse[K comparable, V any](a, b *mapNode[K, V]) *mapNode[K, V] {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case !a.red && b.red:
+		return newMapNode(true, fuse(a, b.left), b, b.right)
+	case a.red && !b.red:
+		return newMapNode(true, a.left, a, fuse(a.right, b))
+	}
+
+	middle := fuse(a.right, b.left)
+	if a.red {
+		if isRed(middle) {
+			return newMapNode(true, newMapNode(true, a.left, a, middle.left), middle, newMapNode(true, middle.right, b, b.right))
+		}
+		return newMapNode(true, a.left, a, newMapNode(true, middle, b, b.right))
+	}
+
+	if isRed(middle) {
+		return newMapNode(true, newMapNode(false, a.left, a, middle.left), middle, newMapNode(false, middle.right, b, b.right))
+	}
+	return balanceLeft(a.left, a, newMapNode(false, middle, b, b.right))
+}
@@ -0,0 +1,204 @@
+package tree
+
+import (
+	"math/bits"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+// checkRedBlack verifies ordering, that no red node has a red child and that
+// every path has the same number of black nodes, and returns that number.
+func checkRedBlack(t *testing.T, n *mapNode[int, int], lo, hi *int) int {
+	t.Helper()
+
+	if n == nil {
+		return 1
+	}
+	if (lo != nil && n.key <= *lo) || (hi != nil && n.key >= *hi) {
+		t.Fatalf("key %d is out of order", n.key)
+	}
+	if n.red && (isRed(n.left) || isRed(n.right)) {
+		t.Fatalf("red node %d has a red child", n.key)
+	}
+
+	left := checkRedBlack(t, n.left, lo, &n.key)
+	right := checkRedBlack(t, n.right, &n.key, hi)
+	if left != right {
+		t.Fatalf("node %d has black heights %d and %d", n.key, left, right)
+	}
+	if !n.red {
+		left++
+	}
+	return left
+}
+
+func entries(m *PersistentMap[int, int]) [][2]int {
+	var out [][2]int
+	m.Range(func(k, v int) bool {
+		out = append(out, [2]int{k, v})
+		return true
+	})
+	return out
+}
+
+func sortedEntries(ref map[int]int) [][2]int {
+	var out [][2]int
+	for k, v := range ref {
+		out = append(out, [2]int{k, v})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i][0] < out[j][0] })
+	return out
+}
+
+func sameEntries(a, b [][2]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// nodes collects every node of a tree.
+func nodes(n *mapNode[int, int], into map[*mapNode[int, int]]bool) {
+	if n != nil {
+		into[n] = true
+		nodes(n.left, into)
+		nodes(n.right, into)
+	}
+}
+
+func TestPersistentMapVersions(t *testing.T) {
+	r := rand.New(rand.NewSource(286))
+
+	versions := []*PersistentMap[int, int]{NewPersistentMap[int, int](intLess)}
+	references := []map[int]int{{}}
+
+	step := 0
+	for step < 2000 {
+		m := versions[len(versions)-1]
+		ref := map[int]int{}
+		for k, v := range references[len(references)-1] {
+			ref[k] = v
+		}
+
+		key := r.Intn(200)
+		if r.Intn(3) == 0 {
+			m = m.Delete(key)
+			delete(ref, key)
+		} else {
+			m = m.Insert(key, step)
+			ref[key] = step
+		}
+		checkRedBlack(t, m.root, nil, nil)
+		if isRed(m.root) {
+			t.Fatalf("root is red after step %d", step)
+		}
+
+		versions = append(versions, m)
+		references = append(references, ref)
+		step++
+	}
+
+	// Every old version must still hold exactly what it held when created.
+	for i, m := range versions {
+		want := sortedEntries(references[i])
+		if m.Len() != len(want) {
+			t.Fatalf("version %d: Len() = %d, want %d", i, m.Len(), len(want))
+		}
+		if got := entries(m); !sameEntries(got, want) {
+			t.Fatalf("version %d: Range = %v, want %v", i, got, want)
+		}
+		for k, v := range references[i] {
+			if got, ok := m.Get(k); !ok || got != v {
+				t.Fatalf("version %d: Get(%d) = %d, %v, want %d", i, k, got, ok, v)
+			}
+		}
+	}
+}
+
+func TestPersistentMapInsertLeavesOriginal(t *testing.T) {
+	m := NewPersistentMap[int, string](intLess).Insert(1, "one").Insert(2, "two")
+	next := m.Insert(3, "three").Insert(1, "uno")
+
+	if _, ok := m.Get(3); ok || m.Len() != 2 {
+		t.Errorf("original gained key 3 (Len %d)", m.Len())
+	}
+	if v, _ := m.Get(1); v != "one" {
+		t.Errorf("original Get(1) = %q, want one", v)
+	}
+	if v, _ := next.Get(1); v != "uno" || next.Len() != 3 {
+		t.Errorf("new version Get(1) = %q with Len %d, want uno and 3", v, next.Len())
+	}
+
+	removed := next.Delete(2)
+	if _, ok := next.Get(2); !ok {
+		t.Errorf("Delete removed key 2 from the version it was called on")
+	}
+	if _, ok := removed.Get(2); ok || removed.Len() != 2 {
+		t.Errorf("Delete left key 2 (Len %d)", removed.Len())
+	}
+	if same := removed.Delete(42); same != removed {
+		t.Errorf("Delete of an absent key returned a new map")
+	}
+}
+
+func TestPersistentMapSharesStructure(t *testing.T) {
+	const n = 1 << 12
+	m := NewPersistentMap[int, int](intLess)
+	i := 0
+	for i < n {
+		m = m.Insert(i*2, i)
+		i++
+	}
+
+	before := map[*mapNode[int, int]]bool{}
+	nodes(m.root, before)
+	// A red-black tree is at most 2·log2(n+1) deep, and each operation
+	// copies little more than one root-to-leaf path.
+	limit := 4 * bits.Len(n)
+
+	for _, next := range []*PersistentMap[int, int]{m.Insert(n+1, 0), m.Insert(0, -1), m.Delete(n), m.Delete(0)} {
+		after := map[*mapNode[int, int]]bool{}
+		nodes(next.root, after)
+
+		fresh := 0
+		for node := range after {
+			if !before[node] {
+				fresh++
+			}
+		}
+		if fresh > limit {
+			t.Errorf("operation allocated %d new nodes out of %d, want at most %d", fresh, len(after), limit)
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		m.Insert(n+1, 0)
+	})
+	if allocs > float64(limit+1) {
+		t.Errorf("Insert made %v allocations, want at most %d", allocs, limit+1)
+	}
+}
+
+func TestPersistentMapRangeStops(t *testing.T) {
+	m := NewPersistentMap[int, int](intLess)
+	for _, k := range []int{5, 1, 4, 2, 3} {
+		m = m.Insert(k, k*k)
+	}
+
+	var seen []int
+	m.Range(func(k, v int) bool {
+		seen = append(seen, k)
+		return k < 3
+	})
+	if len(seen) != 3 || seen[0] != 1 || seen[2] != 3 {
+		t.Errorf("Range visited %v, want [1 2 3]", seen)
+	}
+}
@@ -0,0 +1,130 @@
+package tree
+
+import "errors"
+
+var (
+	ErrNotTree        = errors.New("tree: edges do not form a tree on the given vertices")
+	ErrTooFewVertices = errors.New("tree: Prüfer sequences need at least two vertices")
+)
+
+// ToPrufer encodes a tree on the vertices 0..n-1 as its Prüfer sequence of
+// length n-2 by repeatedly removing the smallest leaf and recording its
+// neighbour. n must be at least 2, since the empty sequence already stands for
+// the two-vertex tree; smaller n yields ErrTooFewVertices. Edges that don't
+// form such a tree yield ErrNotTree.
+func ToPrufer(edges []Edge, n int) ([]int, error) {
+	if n < 2 {
+		return nil, ErrTooFewVertices
+	}
+	if len(edges) != n-1 {
+		return nil, ErrNotTree
+	}
+
+	adjacency := make([][]int, n)
+	for _, e := range edges {
+		if e.From < 0 || e.From >= n || e.To < 0 || e.To >= n || e.From == e.To {
+			return nil, ErrNotTree
+		}
+		adjacency[e.From] = append(adjacency[e.From], e.To)
+		adjacency[e.To] = append(adjacency[e.To], e.From)
+	}
+
+	// Rooting the tree at n-1, which is never removed, gives every other
+	// vertex the neighbour it will be recorded with.
+	parent := make([]int, n)
+	visited := make([]bool, n)
+	visited[n-1] = true
+	parent[n-1] = -1
+	queue := []int{n - 1}
+	seen := 1
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		for _, u := range adjacency[v] {
+			if !visited[u] {
+				visited[u] = true
+				parent[u] = v
+				queue = append(queue, u)
+				seen++
+			}
+		}
+	}
+	if seen != n {
+		return nil, ErrNotTree
+	}
+
+	degree := make([]int, n)
+	for v, neighbours := range adjacency {
+		degree[v] = len(neighbours)
+	}
+
+	sequence := make([]int, 0, max(n-2, 0))
+	pointer := 0
+	for pointer < n && degree[pointer] != 1 {
+		pointer++
+	}
+	leaf := pointer
+
+	for len(sequence) < n-2 {
+		next := parent[leaf]
+		sequence = append(sequence, next)
+		degree[next]--
+
+		if degree[next] == 1 && next < pointer {
+			leaf = next
+		} else {
+			pointer++
+			for degree[pointer] != 1 {
+				pointer++
+			}
+			leaf = pointer
+		}
+	}
+
+	return sequence, nil
+}
+
+// FromPrufer decodes a Prüfer sequence into the edges of the tree on the
+// vertices 0..len(seq)+1 it describes, so every tree it returns has at least
+// two vertices. An empty sequence is the single edge between 0 and 1. A label
+// outside that range yields nil.
+func FromPrufer(seq []int) []Edge {
+	n := len(seq) + 2
+
+	degree := make([]int, n)
+	for i := range degree {
+		degree[i] = 1
+	}
+	for _, v := range seq {
+		if v < 0 || v >= n {
+			return nil
+		}
+		degree[v]++
+	}
+
+	edges := make([]Edge, 0, n-1)
+	pointer := 0
+	for degree[pointer] != 1 {
+		pointer++
+	}
+	leaf := pointer
+
+	for _, v := range seq {
+		edges = append(edges, Edge{From: leaf, To: v})
+		degree[v]--
+
+		if degree[v] == 1 && v < pointer {
+			leaf = v
+		} else {
+			pointer++
+			for degree[pointer] != 1 {
+				pointer++
+			}
+			leaf = pointer
+		}
+	}
+
+	edges = append(edges, Edge{From: leaf, To: n - 1})
+	return edges
+}
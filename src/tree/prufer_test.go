@@ -0,0 +1,128 @@
+package tree
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func normalizedEdges(edges []Edge) [][2]int {
+	pairs := make([][2]int, len(edges))
+	for i, e := range edges {
+		if e.From < e.To {
+			pairs[i] = [2]int{e.From, e.To}
+		} else {
+			pairs[i] = [2]int{e.To, e.From}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+	return pairs
+}
+
+// randomTree attaches each vertex to an earlier one and then shuffles the
+// labels, so every shape and labelling can turn up.
+func randomTree(r *rand.Rand, n int) []Edge {
+	label := r.Perm(n)
+	edges := make([]Edge, 0, n-1)
+	v := 1
+	for v < n {
+		edges = append(edges, Edge{From: label[v], To: label[r.Intn(v)]})
+		v++
+	}
+	return edges
+}
+
+func TestPruferKnown(t *testing.T) {
+	// A star centred on 0 and a path 0-1-2-3-4.
+	star := []Edge{{From: 0, To: 1}, {From: 0, To: 2}, {From: 3, To: 0}}
+	if got, err := ToPrufer(star, 4); err != nil || !reflect.DeepEqual(got, []int{0, 0}) {
+		t.Errorf("ToPrufer(star) = %v, %v, want [0 0]", got, err)
+	}
+
+	path := []Edge{{From: 0, To: 1}, {From: 1, To: 2}, {From: 2, To: 3}, {From: 3, To: 4}}
+	if got, err := ToPrufer(path, 5); err != nil || !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("ToPrufer(path) = %v, %v, want [1 2 3]", got, err)
+	}
+
+	if got, err := ToPrufer([]Edge{{From: 1, To: 0}}, 2); err != nil || len(got) != 0 {
+		t.Errorf("ToPrufer(single edge) = %v, %v, want empty", got, err)
+	}
+	if got := normalizedEdges(FromPrufer(nil)); !reflect.DeepEqual(got, [][2]int{{0, 1}}) {
+		t.Errorf("FromPrufer(empty) = %v, want the edge 0-1", got)
+	}
+}
+
+func TestPruferRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(249))
+
+	trial := 0
+	for trial < 300 {
+		n := 2 + r.Intn(30)
+		edges := randomTree(r, n)
+
+		seq, err := ToPrufer(edges, n)
+		if err != nil {
+			t.Fatalf("ToPrufer(%v): %v", edges, err)
+		}
+		if len(seq) != n-2 {
+			t.Fatalf("sequence length %d, want %d", len(seq), n-2)
+		}
+		if got, want := normalizedEdges(FromPrufer(seq)), normalizedEdges(edges); !reflect.DeepEqual(got, want) {
+			t.Fatalf("FromPrufer(ToPrufer(%v)) = %v", want, got)
+		}
+		trial++
+	}
+}
+
+func TestPruferSequenceRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2490))
+
+	trial := 0
+	for trial < 300 {
+		seq := make([]int, r.Intn(20))
+		for i := range seq {
+			seq[i] = r.Intn(len(seq) + 2)
+		}
+
+		got, err := ToPrufer(FromPrufer(seq), len(seq)+2)
+		if err != nil || len(got) != len(seq) || (len(seq) > 0 && !reflect.DeepEqual(got, seq)) {
+			t.Fatalf("ToPrufer(FromPrufer(%v)) = %v, %v", seq, got, err)
+		}
+		trial++
+	}
+}
+
+func TestPruferRejectsNonTrees(t *testing.T) {
+	cases := []struct {
+		name  string
+		edges []Edge
+		n     int
+	}{
+		{"too few edges", []Edge{{From: 0, To: 1}}, 3},
+		{"cycle plus isolated vertex", []Edge{{From: 0, To: 1}, {From: 1, To: 2}, {From: 2, To: 0}}, 4},
+		{"duplicate edge", []Edge{{From: 0, To: 1}, {From: 1, To: 0}}, 3},
+		{"self loop", []Edge{{From: 0, To: 0}, {From: 0, To: 1}}, 3},
+		{"label out of range", []Edge{{From: 0, To: 5}}, 2},
+	}
+	for _, c := range cases {
+		if _, err := ToPrufer(c.edges, c.n); err != ErrNotTree {
+			t.Errorf("%s: err = %v, want ErrNotTree", c.name, err)
+		}
+	}
+
+	for _, n := range []int{-1, 0, 1} {
+		if _, err := ToPrufer(nil, n); err != ErrTooFewVertices {
+			t.Errorf("ToPrufer(nil, %d): err = %v, want ErrTooFewVertices", n, err)
+		}
+	}
+
+	if got := FromPrufer([]int{0, 7}); got != nil {
+		t.Errorf("FromPrufer with an out-of-range label = %v, want nil", got)
+	}
+}
@@ -0,0 +1,8 @@
+package tree
+
+// Edge connects two vertices labelled with small non-negative integers. When
+// it appears in an adjacency map, From is the vertex the map is keyed by.
+type Edge struct {
+	From, To int
+	Weight   int
+}
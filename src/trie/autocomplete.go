@@ -0,0 +1,123 @@
+package trie
+
+import (
+	"container/heap"
+	"sort"
+)
+
+type node struct {
+	children map[rune]*node
+	terminal bool
+	weight   int
+}
+
+type suggestion struct {
+	word   string
+	weight int
+}
+
+func better(a, b suggestion) bool {
+	if a.weight != b.weight {
+		return a.weight > b.weight
+	}
+	return a.word < b.word
+}
+
+// suggestionHeap keeps the worst of the current best n at its root, so it can
+// be evicted as soon as something better turns up.
+type suggestionHeap []suggestion
+
+func (h suggestionHeap) Len() int {
+	return len(h)
+}
+
+func (h suggestionHeap) Less(i, j int) bool {
+	return better(h[j], h[i])
+}
+
+func (h suggestionHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *suggestionHeap) Push(x any) {
+	*h = append(*h, x.(suggestion))
+}
+
+func (h *suggestionHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+type Autocomplete struct {
+	root *node
+}
+
+func NewAutocomplete() *Autocomplete {
+	return &Autocomplete{root: &node{children: map[rune]*node{}}}
+}
+
+// Insert adds weight to word's total, so inserting a word repeatedly
+// accumulates its frequency.
+func (a *Autocomplete) Insert(word string, weight int) {
+	current := a.root
+	for _, r := range word {
+		next, ok := current.children[r]
+		if !ok {
+			next = &node{children: map[rune]*node{}}
+			current.children[r] = next
+		}
+		current = next
+	}
+
+	current.terminal = true
+	current.weight += weight
+}
+
+// Suggest returns up to n completions of prefix, heaviest first, with equal
+// weights ordered lexicographically.
+func (a *Autocomplete) Suggest(prefix string, n int) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	current := a.root
+	for _, r := range prefix {
+		next, ok := current.children[r]
+		if !ok {
+			return nil
+		}
+		current = next
+	}
+
+	best := &suggestionHeap{}
+	var collect func(nd *node, word []rune)
+	collect = func(nd *node, word []rune) {
+		if nd.terminal {
+			s := suggestion{word: string(word), weight: nd.weight}
+			if best.Len() < n {
+				heap.Push(best, s)
+			} else if better(s, (*best)[0]) {
+				(*best)[0] = s
+				heap.Fix(best, 0)
+			}
+		}
+
+		for r, child := range nd.children {
+			collect(child, append(word, r))
+		}
+	}
+	collect(current, []rune(prefix))
+
+	sort.Slice(*best, func(i, j int) bool {
+		return better((*best)[i], (*best)[j])
+	})
+
+	words := make([]string, best.Len())
+	for i, s := range *best {
+		words[i] = s.word
+	}
+
+	return words
+}
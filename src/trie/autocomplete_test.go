@@ -0,0 +1,57 @@
+package trie
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAutocompleteSuggest(t *testing.T) {
+	a := NewAutocomplete()
+	a.Insert("car", 5)
+	a.Insert("card", 9)
+	a.Insert("care", 5)
+	a.Insert("cart", 2)
+	a.Insert("cargo", 5)
+	a.Insert("cat", 7)
+	a.Insert("dog", 100)
+
+	cases := []struct {
+		prefix string
+		n      int
+		want   []string
+	}{
+		{"car", 10, []string{"card", "car", "care", "cargo", "cart"}},
+		{"car", 3, []string{"card", "car", "care"}},
+		{"ca", 2, []string{"card", "cat"}},
+		{"", 1, []string{"dog"}},
+		{"card", 5, []string{"card"}},
+		{"cab", 5, nil},
+		{"car", 0, nil},
+	}
+	for _, c := range cases {
+		if got := a.Suggest(c.prefix, c.n); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Suggest(%q, %d) = %v, want %v", c.prefix, c.n, got, c.want)
+		}
+	}
+}
+
+func TestAutocompleteAccumulatesWeight(t *testing.T) {
+	a := NewAutocomplete()
+	a.Insert("go", 3)
+	a.Insert("gopher", 4)
+	a.Insert("go", 2)
+
+	if got, want := a.Suggest("g", 2), []string{"go", "gopher"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Suggest = %v, want %v", got, want)
+	}
+}
+
+func TestAutocompleteUnicode(t *testing.T) {
+	a := NewAutocomplete()
+	a.Insert("über", 1)
+	a.Insert("übel", 2)
+
+	if got, want := a.Suggest("üb", 5), []string{"übel", "über"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Suggest = %v, want %v", got, want)
+	}
+}
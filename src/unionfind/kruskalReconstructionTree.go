@@ -0,0 +1,164 @@
+package unionfind
+
+import (
+	"math/bits"
+	"sort"
+)
+
+type Edge struct {
+	From, To int
+	Weight   int
+}
+
+// ReconstructionTree records the merges Kruskal's algorithm makes. Nodes
+// 0..n-1 are the graph's vertices; each later node joins two components and
+// carries the weight of the edge that merged them. Weights never decrease
+// towards the root, so the lowest common ancestor of two vertices holds the
+// smallest possible maximum edge weight over all paths between them.
+type ReconstructionTree struct {
+	vertices int
+	parent   []int
+	weight   []int
+	depth    []int
+	up       [][]int
+}
+
+// KruskalReconstructionTree builds the tree for a graph on vertices 0..n-1.
+// A disconnected graph yields a forest.
+func KruskalReconstructionTree(n int, edges []Edge) *ReconstructionTree {
+	sorted := make([]Edge, len(edges))
+	copy(sorted, edges)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Weight < sorted[j].Weight
+	})
+
+	t := &ReconstructionTree{
+		vertices: n,
+		parent:   make([]int, n, 2*n),
+		weight:   make([]int, n, 2*n),
+	}
+
+	// node[root] is the tree node standing for the component whose set
+	// representative is root.
+	sets := NewUnionFind(n)
+	node := make([]int, n)
+	i := 0
+	for i < n {
+		t.parent[i] = -1
+		node[i] = i
+		i++
+	}
+
+	for _, e := range sorted {
+		a, b := sets.Find(e.From), sets.Find(e.To)
+		if a == b {
+			continue
+		}
+
+		merged := len(t.parent)
+		t.parent = append(t.parent, -1)
+		t.weight = append(t.weight, e.Weight)
+		t.parent[node[a]] = merged
+		t.parent[node[b]] = merged
+
+		sets.Union(a, b)
+		node[sets.Find(a)] = merged
+	}
+
+	t.buildAncestors()
+	return t
+}
+
+// buildAncestors fills depth and the binary-lifting table. A parent is always
+// created after its children, so walking nodes from the last one down visits
+// every parent first.
+func (t *ReconstructionTree) buildAncestors() {
+	size := len(t.parent)
+	levels := max(bits.Len(uint(size)), 1)
+
+	t.depth = make([]int, size)
+	t.up = make([][]int, levels)
+	t.up[0] = t.parent
+
+	x := size - 1
+	for x >= 0 {
+		if p := t.parent[x]; p != -1 {
+			t.depth[x] = t.depth[p] + 1
+		}
+		x--
+	}
+
+	k := 1
+	for k < levels {
+		t.up[k] = make([]int, size)
+		x := 0
+		for x < size {
+			if mid := t.up[k-1][x]; mid != -1 {
+				t.up[k][x] = t.up[k-1][mid]
+			} else {
+				t.up[k][x] = -1
+			}
+			x++
+		}
+		k++
+	}
+}
+
+// Len returns the number of nodes, vertices included.
+func (t *ReconstructionTree) Len() int {
+	return len(t.parent)
+}
+
+// Parent returns the node that x was merged into, or -1 for a root.
+func (t *ReconstructionTree) Parent(x int) int {
+	return t.parent[x]
+}
+
+// Weight returns the merge weight of an internal node; it is 0 for vertices.
+func (t *ReconstructionTree) Weight(x int) int {
+	return t.weight[x]
+}
+
+func (t *ReconstructionTree) lca(u, v int) int {
+	if t.depth[u] < t.depth[v] {
+		u, v = v, u
+	}
+
+	k := len(t.up) - 1
+	for k >= 0 {
+		if t.depth[u]-(1<<k) >= t.depth[v] {
+			u = t.up[k][u]
+		}
+		k--
+	}
+	if u == v {
+		return u
+	}
+
+	k = len(t.up) - 1
+	for k >= 0 {
+		if t.up[k][u] != t.up[k][v] {
+			u, v = t.up[k][u], t.up[k][v]
+		}
+		k--
+	}
+	if t.parent[u] == -1 {
+		return -1
+	}
+	return t.parent[u]
+}
+
+// Bottleneck returns the smallest value B such that u and v are joined by a
+// path using only edges of weight at most B, in O(log n). It is 0 when u == v
+// and false when they are not connected.
+func (t *ReconstructionTree) Bottleneck(u, v int) (int, bool) {
+	if u == v {
+		return 0, true
+	}
+
+	ancestor := t.lca(u, v)
+	if ancestor == -1 {
+		return 0, false
+	}
+	return t.weight[ancestor], true
+}
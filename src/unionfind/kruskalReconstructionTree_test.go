@@ -0,0 +1,120 @@
+package unionfind
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// bruteBottlenecks computes every pair's minimax path weight with a
+// Floyd–Warshall pass over (min, max); math.MaxInt marks no path. A vertex's
+// bottleneck to itself stays 0, as Bottleneck defines it, even when negative
+// weights are around.
+func bruteBottlenecks(n int, edges []Edge) [][]int {
+	b := make([][]int, n)
+	for i := range b {
+		b[i] = make([]int, n)
+		for j := range b[i] {
+			b[i][j] = math.MaxInt
+		}
+		b[i][i] = 0
+	}
+	for _, e := range edges {
+		if e.From != e.To {
+			b[e.From][e.To] = min(b[e.From][e.To], e.Weight)
+			b[e.To][e.From] = b[e.From][e.To]
+		}
+	}
+
+	k := 0
+	for k < n {
+		i := 0
+		for i < n {
+			j := 0
+			for j < n {
+				if i != j && b[i][k] != math.MaxInt && b[k][j] != math.MaxInt {
+					b[i][j] = min(b[i][j], max(b[i][k], b[k][j]))
+				}
+				j++
+			}
+			i++
+		}
+		k++
+	}
+	return b
+}
+
+func TestReconstructionTreeShape(t *testing.T) {
+	// Two components: a weighted triangle 0-1-2 and the edge 3-4.
+	edges := []Edge{{0, 1, 5}, {1, 2, 3}, {0, 2, 4}, {3, 4, 7}}
+	tree := KruskalReconstructionTree(5, edges)
+
+	// Five vertices plus one merge per spanning-forest edge, of which there
+	// are three.
+	if tree.Len() != 8 {
+		t.Fatalf("Len() = %d, want 8", tree.Len())
+	}
+
+	roots := 0
+	x := 0
+	for x < tree.Len() {
+		p := tree.Parent(x)
+		if p == -1 {
+			roots++
+		} else if p < tree.vertices || tree.Weight(p) < tree.Weight(x) {
+			t.Errorf("node %d (weight %d) has parent %d (weight %d)", x, tree.Weight(x), p, tree.Weight(p))
+		}
+		x++
+	}
+	if roots != 2 {
+		t.Errorf("tree has %d roots, want one per component", roots)
+	}
+
+	cases := []struct {
+		u, v int
+		want int
+		ok   bool
+	}{
+		{1, 2, 3, true},
+		{0, 1, 4, true},
+		{0, 2, 4, true},
+		{3, 4, 7, true},
+		{2, 2, 0, true},
+		{0, 3, 0, false},
+	}
+	for _, c := range cases {
+		if got, ok := tree.Bottleneck(c.u, c.v); got != c.want || ok != c.ok {
+			t.Errorf("Bottleneck(%d, %d) = %d, %v, want %d, %v", c.u, c.v, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestReconstructionTreeMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(285))
+
+	trial := 0
+	for trial < 200 {
+		n := 1 + r.Intn(12)
+		edges := make([]Edge, r.Intn(2*n))
+		for i := range edges {
+			edges[i] = Edge{From: r.Intn(n), To: r.Intn(n), Weight: r.Intn(20) - 5}
+		}
+
+		tree := KruskalReconstructionTree(n, edges)
+		want := bruteBottlenecks(n, edges)
+
+		u := 0
+		for u < n {
+			v := 0
+			for v < n {
+				got, ok := tree.Bottleneck(u, v)
+				if ok != (want[u][v] != math.MaxInt) || (ok && got != want[u][v]) {
+					t.Fatalf("Bottleneck(%d, %d) = %d, %v, want %d on %v", u, v, got, ok, want[u][v], edges)
+				}
+				v++
+			}
+			u++
+		}
+		trial++
+	}
+}
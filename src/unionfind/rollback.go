@@ -0,0 +1,93 @@
+package unionfind
+
+type change struct {
+	child    int
+	root     int
+	rankGrew bool
+}
+
+// Rollback is a disjoint-set forest over 0..n-1 whose unions can be undone in
+// reverse order. It uses union by rank without path compression, so Find is
+// O(log n) and every union changes a single parent pointer that is recorded
+// on a stack.
+type Rollback struct {
+	parent     []int
+	rank       []int
+	history    []change
+	components int
+}
+
+func NewRollback(n int) *Rollback {
+	r := &Rollback{
+		parent:     make([]int, n),
+		rank:       make([]int, n),
+		components: n,
+	}
+
+	i := 0
+	for i < n {
+		r.parent[i] = i
+		i++
+	}
+
+	return r
+}
+
+func (r *Rollback) Find(x int) int {
+	for r.parent[x] != x {
+		x = r.parent[x]
+	}
+	return x
+}
+
+// Union merges the sets containing a and b and reports whether they were
+// separate. Unions of already connected elements record nothing.
+func (r *Rollback) Union(a, b int) bool {
+	a, b = r.Find(a), r.Find(b)
+	if a == b {
+		return false
+	}
+
+	if r.rank[a] > r.rank[b] {
+		a, b = b, a
+	}
+	grew := r.rank[a] == r.rank[b]
+
+	r.parent[a] = b
+	if grew {
+		r.rank[b]++
+	}
+	r.history = append(r.history, change{child: a, root: b, rankGrew: grew})
+	r.components--
+
+	return true
+}
+
+func (r *Rollback) Connected(a, b int) bool {
+	return r.Find(a) == r.Find(b)
+}
+
+func (r *Rollback) Components() int {
+	return r.components
+}
+
+// Snapshot returns a marker for the current state that Rollback can return
+// to.
+func (r *Rollback) Snapshot() int {
+	return len(r.history)
+}
+
+// Rollback undoes every union made since snapshot was taken. Snapshots taken
+// after snapshot become invalid.
+func (r *Rollback) Rollback(snapshot int) {
+	for len(r.history) > snapshot {
+		last := r.history[len(r.history)-1]
+		r.history = r.history[:len(r.history)-1]
+
+		r.parent[last.child] = last.child
+		if last.rankGrew {
+			r.rank[last.root]--
+		}
+		r.components++
+	}
+}
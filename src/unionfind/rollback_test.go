@@ -0,0 +1,100 @@
+package unionfind
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func connectivity(r *Rollback, n int) [][]bool {
+	m := make([][]bool, n)
+	for i := range m {
+		m[i] = make([]bool, n)
+		for j := range m[i] {
+			m[i][j] = r.Connected(i, j)
+		}
+	}
+	return m
+}
+
+func TestRollbackRestoresSnapshot(t *testing.T) {
+	const n = 8
+	r := NewRollback(n)
+	r.Union(0, 1)
+	r.Union(2, 3)
+	r.Union(1, 3)
+
+	snap := r.Snapshot()
+	before := connectivity(r, n)
+	parent := append([]int{}, r.parent...)
+	rank := append([]int{}, r.rank...)
+
+	r.Union(4, 5)
+	r.Union(5, 0)
+	r.Union(6, 7)
+	if !r.Connected(4, 2) || r.Components() != 2 {
+		t.Fatalf("after more unions: Connected(4, 2) = %v, Components = %d", r.Connected(4, 2), r.Components())
+	}
+
+	r.Rollback(snap)
+	if got := connectivity(r, n); !reflect.DeepEqual(got, before) {
+		t.Errorf("connectivity after Rollback differs from the snapshot")
+	}
+	if !reflect.DeepEqual(r.parent, parent) || !reflect.DeepEqual(r.rank, rank) {
+		t.Errorf("forest after Rollback = %v / %v, want %v / %v", r.parent, r.rank, parent, rank)
+	}
+	if r.Components() != 5 {
+		t.Errorf("Components = %d, want 5", r.Components())
+	}
+}
+
+func TestRollbackNested(t *testing.T) {
+	const n = 30
+	rnd := rand.New(rand.NewSource(255))
+	r := NewRollback(n)
+
+	var snaps []int
+	var states [][][]bool
+	var components []int
+
+	step := 0
+	for step < 300 {
+		switch {
+		case rnd.Intn(5) == 0:
+			snaps = append(snaps, r.Snapshot())
+			states = append(states, connectivity(r, n))
+			components = append(components, r.Components())
+		case rnd.Intn(4) == 0 && len(snaps) > 0:
+			last := len(snaps) - 1
+			r.Rollback(snaps[last])
+			if got := connectivity(r, n); !reflect.DeepEqual(got, states[last]) {
+				t.Fatalf("step %d: connectivity after Rollback differs from the snapshot", step)
+			}
+			if r.Components() != components[last] {
+				t.Fatalf("step %d: Components = %d, want %d", step, r.Components(), components[last])
+			}
+			snaps, states, components = snaps[:last], states[:last], components[:last]
+		default:
+			r.Union(rnd.Intn(n), rnd.Intn(n))
+		}
+		step++
+	}
+}
+
+func TestRollbackUnionReport(t *testing.T) {
+	r := NewRollback(3)
+	if !r.Union(0, 1) || r.Union(1, 0) || r.Union(2, 2) {
+		t.Errorf("Union should report only merges of separate sets")
+	}
+
+	// A no-op union records nothing, so one rollback step undoes the merge.
+	snap := r.Snapshot()
+	r.Union(0, 1)
+	if r.Snapshot() != snap {
+		t.Errorf("redundant Union was recorded")
+	}
+	r.Rollback(0)
+	if r.Connected(0, 1) || r.Components() != 3 {
+		t.Errorf("Rollback(0) left 0 and 1 connected")
+	}
+}
@@ -0,0 +1,67 @@
+package unionfind
+
+// UnionFind is a disjoint-set forest over 0..n-1 with union by rank and path
+// compression, giving near-constant amortized operations. Use Rollback when
+// unions need to be undone.
+type UnionFind struct {
+	parent     []int
+	rank       []int
+	components int
+}
+
+func NewUnionFind(n int) *UnionFind {
+	u := &UnionFind{
+		parent:     make([]int, n),
+		rank:       make([]int, n),
+		components: n,
+	}
+
+	i := 0
+	for i < n {
+		u.parent[i] = i
+		i++
+	}
+
+	return u
+}
+
+func (u *UnionFind) Find(x int) int {
+	root := x
+	for u.parent[root] != root {
+		root = u.parent[root]
+	}
+
+	for u.parent[x] != root {
+		u.parent[x], x = root, u.parent[x]
+	}
+
+	return root
+}
+
+// Union merges the sets containing a and b and reports whether they were
+// separate.
+func (u *UnionFind) Union(a, b int) bool {
+	a, b = u.Find(a), u.Find(b)
+	if a == b {
+		return false
+	}
+
+	if u.rank[a] > u.rank[b] {
+		a, b = b, a
+	}
+	u.parent[a] = b
+	if u.rank[a] == u.rank[b] {
+		u.rank[b]++
+	}
+	u.components--
+
+	return true
+}
+
+func (u *UnionFind) Connected(a, b int) bool {
+	return u.Find(a) == u.Find(b)
+}
+
+func (u *UnionFind) Components() int {
+	return u.components
+}
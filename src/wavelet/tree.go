@@ -0,0 +1,121 @@
+package wavelet
+
+// Each node covers the values in [lo, hi] and keeps the elements that fall in
+// that range in their original order. left[i] counts how many of the first i
+// of them belong to the lower half, which is all that is needed to map a
+// position range onto either child.
+type node struct {
+	lo, hi int
+	left   []int
+	lower  *node
+	upper  *node
+}
+
+// Tree answers order statistics over subarrays in O(log(max - min)) per query
+// after O(n log(max - min)) construction.
+type Tree struct {
+	root *node
+	size int
+}
+
+func NewTree(values []int) *Tree {
+	t := &Tree{size: len(values)}
+	if len(values) == 0 {
+		return t
+	}
+
+	lo, hi := values[0], values[0]
+	for _, v := range values {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+
+	elements := make([]int, len(values))
+	copy(elements, values)
+	t.root = build(elements, lo, hi)
+
+	return t
+}
+
+func build(elements []int, lo, hi int) *node {
+	n := &node{lo: lo, hi: hi}
+	if lo == hi || len(elements) == 0 {
+		return n
+	}
+
+	mid := lo + (hi-lo)/2
+	n.left = make([]int, len(elements)+1)
+
+	var lower, upper []int
+	i := 0
+	for i < len(elements) {
+		n.left[i+1] = n.left[i]
+		if elements[i] <= mid {
+			n.left[i+1]++
+			lower = append(lower, elements[i])
+		} else {
+			upper = append(upper, elements[i])
+		}
+		i++
+	}
+
+	n.lower = build(lower, lo, mid)
+	n.upper = build(upper, mid+1, hi)
+
+	return n
+}
+
+func (t *Tree) Len() int {
+	return t.size
+}
+
+func (t *Tree) checkRange(l, r int) {
+	if l < 0 || r >= t.size || l > r {
+		panic("wavelet: range out of bounds")
+	}
+}
+
+// KthSmallest returns the k-th smallest value, counting from 1, among the
+// elements at positions [l, r]. It panics if the range is invalid or k is not
+// in [1, r - l + 1].
+func (t *Tree) KthSmallest(l, r, k int) int {
+	t.checkRange(l, r)
+	if k < 1 || k > r-l+1 {
+		panic("wavelet: k out of range")
+	}
+
+	n := t.root
+	end := r + 1
+	for n.lo != n.hi {
+		inLower := n.left[end] - n.left[l]
+		if k <= inLower {
+			l, end = n.left[l], n.left[end]
+			n = n.lower
+		} else {
+			k -= inLower
+			l, end = l-n.left[l], end-n.left[end]
+			n = n.upper
+		}
+	}
+
+	return n.lo
+}
+
+// RangeCount returns how many elements at positions [l, r] have a value in
+// [lo, hi]. It panics if the position range is invalid.
+func (t *Tree) RangeCount(l, r, lo, hi int) int {
+	t.checkRange(l, r)
+	return count(t.root, l, r+1, lo, hi)
+}
+
+func count(n *node, l, end, lo, hi int) int {
+	if l >= end || hi < n.lo || lo > n.hi {
+		return 0
+	}
+	if lo <= n.lo && n.hi <= hi {
+		return end - l
+	}
+
+	return count(n.lower, n.left[l], n.left[end], lo, hi) +
+		count(n.upper, l-n.left[l], end-n.left[end], lo, hi)
+}
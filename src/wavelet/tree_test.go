@@ -0,0 +1,102 @@
+package wavelet
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestKthSmallestAgainstSorting(t *testing.T) {
+	r := rand.New(rand.NewSource(254))
+
+	trial := 0
+	for trial < 100 {
+		n := 1 + r.Intn(50)
+		spread := 1 + r.Intn(40)
+		values := make([]int, n)
+		for i := range values {
+			values[i] = r.Intn(spread) - spread/2
+		}
+		tree := NewTree(values)
+
+		query := 0
+		for query < 30 {
+			l := r.Intn(n)
+			right := l + r.Intn(n-l)
+			window := append([]int{}, values[l:right+1]...)
+			sort.Ints(window)
+
+			k := 1 + r.Intn(len(window))
+			if got := tree.KthSmallest(l, right, k); got != window[k-1] {
+				t.Fatalf("values %v: KthSmallest(%d, %d, %d) = %d, want %d", values, l, right, k, got, window[k-1])
+			}
+			query++
+		}
+		trial++
+	}
+}
+
+func TestRangeCountAgainstBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(2540))
+
+	trial := 0
+	for trial < 100 {
+		n := 1 + r.Intn(50)
+		values := make([]int, n)
+		for i := range values {
+			values[i] = r.Intn(30) - 10
+		}
+		tree := NewTree(values)
+
+		query := 0
+		for query < 30 {
+			l := r.Intn(n)
+			right := l + r.Intn(n-l)
+			lo := r.Intn(50) - 20
+			hi := lo + r.Intn(30) - 5
+
+			want := 0
+			for _, v := range values[l : right+1] {
+				if lo <= v && v <= hi {
+					want++
+				}
+			}
+			if got := tree.RangeCount(l, right, lo, hi); got != want {
+				t.Fatalf("values %v: RangeCount(%d, %d, %d, %d) = %d, want %d", values, l, right, lo, hi, got, want)
+			}
+			query++
+		}
+		trial++
+	}
+}
+
+func TestTreeConstantValues(t *testing.T) {
+	tree := NewTree([]int{7, 7, 7})
+	if got := tree.KthSmallest(0, 2, 2); got != 7 {
+		t.Errorf("KthSmallest = %d, want 7", got)
+	}
+	if got := tree.RangeCount(1, 2, 7, 7); got != 2 {
+		t.Errorf("RangeCount = %d, want 2", got)
+	}
+}
+
+func TestTreePanics(t *testing.T) {
+	tree := NewTree([]int{3, 1, 2})
+	cases := map[string]func(){
+		"range past the end": func() { tree.KthSmallest(0, 3, 1) },
+		"reversed range":     func() { tree.RangeCount(2, 1, 0, 5) },
+		"k too large":        func() { tree.KthSmallest(0, 1, 3) },
+		"k zero":             func() { tree.KthSmallest(0, 1, 0) },
+		"empty tree":         func() { NewTree(nil).KthSmallest(0, 0, 1) },
+	}
+	for name, f := range cases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: no panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}